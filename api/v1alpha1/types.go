@@ -50,6 +50,47 @@ const (
 const (
 	// LoadBalancerReadyCondition reports on whether a control plane load balancer was successfully reconciled.
 	LoadBalancerReadyCondition clusterv1.ConditionType = "LoadBalancerReady"
+	// NetworkReadyCondition reports on whether a managed network was successfully reconciled.
+	NetworkReadyCondition clusterv1.ConditionType = "NetworkReady"
+	// PlacementGroupReadyCondition reports on whether a control plane placement group was successfully reconciled.
+	PlacementGroupReadyCondition clusterv1.ConditionType = "PlacementGroupReady"
+	// NodeSecurityGroupReadyCondition reports on whether a cluster node security group was successfully reconciled.
+	NodeSecurityGroupReadyCondition clusterv1.ConditionType = "NodeSecurityGroupReady"
+	// IPAddressClaimedCondition reports on whether the machine internal addresses were allocated from the IPAM pools.
+	IPAddressClaimedCondition clusterv1.ConditionType = "IPAddressClaimed"
+	// BootstrapDataPurgedCondition reports on whether the bootstrap data was removed from the instance metadata
+	// after the node has joined the cluster.
+	BootstrapDataPurgedCondition clusterv1.ConditionType = "BootstrapDataPurged"
+	// InstanceSpecInSyncCondition reports on whether the instance matches the machine specification.
+	InstanceSpecInSyncCondition clusterv1.ConditionType = "SpecInSync"
+	// InstanceResizedCondition reports on whether the in-place resize of the instance platform and resources is done.
+	InstanceResizedCondition clusterv1.ConditionType = "InstanceResized"
+	// InstanceGroupReadyCondition reports on whether a machine pool instance group was successfully reconciled.
+	InstanceGroupReadyCondition clusterv1.ConditionType = "InstanceGroupReady"
 	// LoadBalancerFailedReason used when an error occurs during load balancer reconciliation.
 	LoadBalancerFailedReason = "LoadBalancerFailed"
+	// InstanceGroupFailedReason used when an error occurs during instance group reconciliation.
+	InstanceGroupFailedReason = "InstanceGroupFailed"
+	// InstanceGroupUpdatingReason used when the instance group instances are being created or updated.
+	InstanceGroupUpdatingReason = "InstanceGroupUpdating"
+	// InstancePreemptedReason used when a preemptible instance has been stopped by Compute.
+	InstancePreemptedReason = "InstancePreempted"
+	// WaitingForIPAddressReason used when the IPAM provider has not allocated the claimed address yet.
+	WaitingForIPAddressReason = "WaitingForIPAddress"
+	// IPAddressClaimFailedReason used when an error occurs during IP address claims reconciliation.
+	IPAddressClaimFailedReason = "IPAddressClaimFailed"
+	// WaitingForNodeReason used when the node of the machine has not joined the cluster yet.
+	WaitingForNodeReason = "WaitingForNode"
+	// InstanceSpecDriftedReason used when the instance has been changed outside of the provider.
+	InstanceSpecDriftedReason = "InstanceSpecDrifted"
+	// InstanceStoppingForResizeReason used when the instance is being stopped to change its platform and resources.
+	InstanceStoppingForResizeReason = "InstanceStoppingForResize"
+	// InstanceUpdatingResourcesReason used when the stopped instance platform and resources are being updated.
+	InstanceUpdatingResourcesReason = "InstanceUpdatingResources"
+	// InstanceStartingAfterResizeReason used when the instance is being started after its resources were updated.
+	InstanceStartingAfterResizeReason = "InstanceStartingAfterResize"
+	// InstanceResizeFailedReason used when the instance has got into an unexpected state during the in-place resize.
+	InstanceResizeFailedReason = "InstanceResizeFailed"
+	// BootstrapDataPurgeFailedReason used when an error occurs during the bootstrap data removal from instance metadata.
+	BootstrapDataPurgeFailedReason = "BootstrapDataPurgeFailed"
 )
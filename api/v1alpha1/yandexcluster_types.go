@@ -29,6 +29,10 @@ const (
 	LoadBalancerTypeALB LoadBalancerType = "ALB"
 	// LoadBalancerTypeNLB is the name of the network load balancer type.
 	LoadBalancerTypeNLB LoadBalancerType = "NLB"
+	// IPVersionIPv4 is the IPv4 protocol version.
+	IPVersionIPv4 IPVersion = "IPv4"
+	// IPVersionIPv6 is the IPv6 protocol version.
+	IPVersionIPv6 IPVersion = "IPv6"
 )
 
 //+kubebuilder:validation:Required
@@ -47,6 +51,9 @@ type Labels map[string]string
 // ALB https://yandex.cloud/ru/services/application-load-balancer .
 type LoadBalancerType string
 
+// IPVersion is a version of the IP protocol.
+type IPVersion string
+
 // YandexClusterSpec defines the desired state of YandexCluster.
 type YandexClusterSpec struct {
 	// NetworkSpec encapsulates all things related to Yandex network.
@@ -64,6 +71,11 @@ type YandexClusterSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	FolderID string `json:"folderID"`
 
+	// IdentityRef is the reference to the YandexClusterIdentity with the credentials used to manage the cluster resources.
+	// If IdentityRef not provided, the credentials of the controller are used.
+	// +optional
+	IdentityRef *IdentityReference `json:"identityRef,omitempty"`
+
 	// LoadBalancer is a loadbalancer configuration for the kubernetes cluster API.
 	// +required
 	LoadBalancer LoadBalancerSpec `json:"loadBalancer"`
@@ -71,6 +83,73 @@ type YandexClusterSpec struct {
 	// Labels is an optional set of labels to add to Yandex resources managed by the CAPY provider.
 	// +optional
 	Labels Labels `json:"labels,omitempty"`
+
+	// FailureDomains is a list of YandexCloud availability zones used to spread the cluster machines.
+	// The failure domains are published in the YandexCluster status, so the control plane and machine
+	// deployments could place the machines in the different zones.
+	// +optional
+	// +listType=map
+	// +listMapKey=zoneID
+	FailureDomains []FailureDomainSpec `json:"failureDomains,omitempty"`
+
+	// ControlPlanePlacementGroup configures the spread placement group managed by the provider
+	// for the control plane machines, so they are placed on different physical hosts.
+	// The spread placement group can hold no more than 5 instances in each availability zone.
+	// More information https://yandex.cloud/ru/docs/compute/concepts/placement-groups .
+	// +optional
+	ControlPlanePlacementGroup *PlacementGroupSpec `json:"controlPlanePlacementGroup,omitempty"`
+
+	// NodeSecurityGroup configures the security group managed by the provider for the cluster machines.
+	// The security group allows the traffic between the cluster machines, including kubelet and CNI,
+	// the kubernetes API traffic from the application load balancer and the load balancer health checks.
+	// The network load balancer keeps the client addresses, so the kubernetes API traffic through it
+	// has to be allowed by the security groups in the securityGroupIDs of the control plane machines.
+	// The security group is attached to the load balancer target network interface of every cluster machine.
+	// More information https://yandex.cloud/ru/docs/vpc/concepts/security-groups.
+	// +optional
+	NodeSecurityGroup *SecurityGroupSpec `json:"nodeSecurityGroup,omitempty"`
+}
+
+// SecurityGroupSpec configures the managed security group.
+type SecurityGroupSpec struct {
+	// Name is the name of the security group.
+	// If Name not provided, the name will be generated from the cluster name.
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`([a-z]([-a-z0-9]{0,61}[a-z0-9])?)?`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// IPv6 allows the IPv6 traffic of the cluster machines, it has to be set if the machines have IPv6 addresses.
+	// The IPv6 traffic is allowed regardless of the field if the load balancer listener has an IPv6 address.
+	// +optional
+	IPv6 bool `json:"ipv6,omitempty"`
+}
+
+// PlacementGroupSpec configures the managed spread placement group.
+type PlacementGroupSpec struct {
+	// Name is the name of the placement group.
+	// If Name not provided, the name will be generated from the cluster name.
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`([a-z]([-a-z0-9]{0,61}[a-z0-9])?)?`
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// FailureDomainSpec configures a YandexCloud availability zone used to place the cluster machines.
+type FailureDomainSpec struct {
+	// ZoneID is the identifier of YandexCloud availability zone.
+	// +kubebuilder:validation:MinLength=1
+	ZoneID string `json:"zoneID"`
+
+	// SubnetID is the identifier of the subnet in the availability zone.
+	// The subnet is used for the machine network interfaces which do not set the subnet explicitly.
+	// If SubnetID not provided, the managed network subnet of the availability zone is used.
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// ControlPlane determines if this failure domain is suitable for use by control plane machines.
+	// +optional
+	ControlPlane bool `json:"controlPlane,omitempty"`
 }
 
 // LoadBalancerSpec is a loadbalancer configuration for the kubernetes cluster API.
@@ -107,6 +186,14 @@ type LoadBalancerSpec struct {
 	// +kubebuilder:default={}
 	Healthcheck HealtcheckSpec `json:"healthcheck,omitempty"`
 
+	// Locations is a list of availability zones and subnets where the load balancer nodes will be allocated.
+	// Each location must reside in a different availability zone.
+	// If Locations not provided, the load balancer will be allocated in the listener subnet zone only.
+	// Locations are supported by the ALB load balancer type only.
+	// More information https://yandex.cloud/ru/docs/application-load-balancer/concepts/application-load-balancer#lb-location.
+	// +optional
+	Locations []SubnetSpec `json:"locations,omitempty"`
+
 	// SecurityGroups sets the security groups ID used by the load balancer.
 	// If SecurityGroups not provided, new security group will be created for the load balancer.
 	// More information https://yandex.cloud/ru/docs/vpc/concepts/security-groups.
@@ -118,9 +205,20 @@ type LoadBalancerSpec struct {
 // More information https://yandex.cloud/ru/docs/application-load-balancer/concepts/application-load-balancer#listener.
 type ListenerSpec struct {
 	// load balancer listener ip address.
+	// For the external listener the address must be a static public address
+	// reserved in the same folder, otherwise a public address is allocated automatically.
+	// The address version must match the listener IPVersion.
 	// +optional
 	Address string `json:"address,omitempty"`
 
+	// IPVersion is the IP protocol version of the listener address, possible values are: IPv4 and IPv6.
+	// The ALB load balancer supports the IPv6 address for the external listener only.
+	// The machine network interfaces registered in the load balancer must have an address of the same version.
+	// +optional
+	// +kubebuilder:default=IPv4
+	// +kubebuilder:validation:Enum:=IPv4;IPv6
+	IPVersion IPVersion `json:"ipVersion,omitempty"`
+
 	// load balancer listener port. Acceptable values are 1 to 65535, inclusive.
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
@@ -129,6 +227,7 @@ type ListenerSpec struct {
 	Port int32 `json:"port,omitempty"`
 
 	// If Internal value is true, then a private IP will be used for the listener address.
+	// If Internal value is false, then a public address will be used for the listener address.
 	// +kubebuilder:default=true
 	// +optional
 	Internal bool `json:"internal,omitempty"`
@@ -145,6 +244,7 @@ type SubnetSpec struct {
 	ZoneID string `json:"zoneID,omitempty"`
 
 	// ID defines a unique identificator of the subnet to be used.
+	// If ID not provided, the managed network subnet of the availability zone is used.
 	ID string `json:"id,omitempty"`
 }
 
@@ -164,8 +264,51 @@ type HealtcheckSpec struct {
 // NetworkSpec encapsulates all things related to Yandex network.
 type NetworkSpec struct {
 	// ID is the unique identificator of the cloud network to be used.
+	// If ID not provided, the network will be created and managed by the provider.
 	// More information https://yandex.cloud/ru/docs/vpc/concepts/network.
 	ID string `json:"id,omitempty"`
+
+	// Name is the name of the managed network.
+	// If Name not provided, the name will be generated from the cluster name.
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`([a-z]([-a-z0-9]{0,61}[a-z0-9])?)?`
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Subnets is a list of subnets to be created in the managed network, one per availability zone.
+	// Subnets could be set for the managed network only.
+	// +optional
+	// +listType=map
+	// +listMapKey=zoneID
+	Subnets []NetworkSubnetSpec `json:"subnets,omitempty"`
+
+	// NATGateway configures the egress NAT gateway for the managed network subnets.
+	// If NATGateway is set, the provider creates a shared egress gateway and a route table,
+	// which routes all outgoing traffic of the managed subnets through the gateway.
+	// NATGateway could be set for the managed network only.
+	// +optional
+	NATGateway *NATGatewaySpec `json:"natGateway,omitempty"`
+}
+
+// NATGatewaySpec configures the egress NAT gateway of the managed network.
+type NATGatewaySpec struct {
+	// Name is the name of the NAT gateway.
+	// If Name not provided, the name will be generated from the cluster name.
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`([a-z]([-a-z0-9]{0,61}[a-z0-9])?)?`
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// NetworkSubnetSpec configures a subnet of the managed network.
+type NetworkSubnetSpec struct {
+	// ZoneID is the identifier of YandexCloud availability zone where the subnet resides.
+	// +kubebuilder:validation:MinLength=1
+	ZoneID string `json:"zoneID"`
+
+	// CIDRBlock is the IPv4 CIDR block of the subnet, for example 10.1.0.0/24.
+	// +kubebuilder:validation:MinLength=1
+	CIDRBlock string `json:"cidrBlock"`
 }
 
 // YandexClusterStatus defines the observed state of YandexCluster.
@@ -175,6 +318,53 @@ type YandexClusterStatus struct {
 	Ready        bool                 `json:"ready"`
 	LoadBalancer LoadBalancerStatus   `json:"loadBalancerStatus,omitempty"`
 	Conditions   clusterv1.Conditions `json:"conditions,omitempty"`
+
+	// FailureDomains is a list of failure domain objects synced from the YandexCluster specification.
+	// +optional
+	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
+
+	// Network encapsulates the resources of the managed network.
+	// +optional
+	Network NetworkStatus `json:"network,omitempty"`
+
+	// ControlPlanePlacementGroupID is the identifier of the managed control plane placement group.
+	// +optional
+	ControlPlanePlacementGroupID string `json:"controlPlanePlacementGroupID,omitempty"`
+
+	// NodeSecurityGroupID is the identifier of the managed node security group.
+	// +optional
+	NodeSecurityGroupID string `json:"nodeSecurityGroupID,omitempty"`
+}
+
+// NetworkStatus encapsulates the managed network resources.
+type NetworkStatus struct {
+	// ID is the identifier of the managed network.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Subnets is a list of the managed network subnets.
+	// +optional
+	Subnets []SubnetStatus `json:"subnets,omitempty"`
+
+	// NATGatewayID is the identifier of the managed NAT gateway.
+	// +optional
+	NATGatewayID string `json:"natGatewayID,omitempty"`
+
+	// RouteTableID is the identifier of the managed route table attached to the managed subnets.
+	// +optional
+	RouteTableID string `json:"routeTableID,omitempty"`
+}
+
+// SubnetStatus encapsulates the managed subnet information.
+type SubnetStatus struct {
+	// ID is the identifier of the subnet.
+	ID string `json:"id"`
+
+	// ZoneID is the identifier of YandexCloud availability zone where the subnet resides.
+	ZoneID string `json:"zoneID"`
+
+	// CIDRBlock is the IPv4 CIDR block of the subnet.
+	CIDRBlock string `json:"cidrBlock"`
 }
 
 // LoadBalancerStatus encapsulates load balancer resources.
@@ -70,22 +70,15 @@ func (c *YandexCluster) ValidateCreate() (admission.Warnings, error) {
 	yandexclusterlog.Info("validate create", "name", c.Name)
 	var allErrs field.ErrorList
 
-	if c.Spec.LoadBalancer.Type == LoadBalancerTypeNLB {
-		allErrs = append(allErrs,
-			field.Invalid(field.NewPath("spec", "loadBalancer", "type"),
-				c.Spec.LoadBalancer.Type, "network load balancer support will be added in future releases, use application load balancer instead"),
-		)
-	}
+	allErrs = append(allErrs, validateListener(c.Spec.LoadBalancer)...)
+	allErrs = append(allErrs, validateNetwork(c.Spec)...)
 
-	if c.Spec.LoadBalancer.Listener.Address != "" && !isIPv4(c.Spec.LoadBalancer.Listener.Address) {
-		allErrs = append(allErrs,
-			field.Invalid(field.NewPath("spec", "loadBalancer", "listener", "address"),
-				c.Spec.LoadBalancer.Listener.Address, "field must be a valid IPv4 address"),
-		)
+	if len(c.Spec.LoadBalancer.Locations) > 0 {
+		allErrs = append(allErrs, validateLocations(c.Spec)...)
 	}
 
 	if !reflect.DeepEqual(c.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) {
-		allErrs = append(allErrs, isControlPlaneEndpointValid(c.Spec.ControlPlaneEndpoint)...)
+		allErrs = append(allErrs, isControlPlaneEndpointValid(c.Spec.ControlPlaneEndpoint, c.Spec.LoadBalancer.Listener.IPVersion)...)
 	}
 
 	if len(allErrs) == 0 {
@@ -110,11 +103,37 @@ func (c *YandexCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warning
 		)
 	}
 
+	if !reflect.DeepEqual(old.Spec.NetworkSpec, c.Spec.NetworkSpec) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "network"), c.Spec.NetworkSpec, "field is immutable"),
+		)
+	}
+
+	if !reflect.DeepEqual(old.Spec.IdentityRef, c.Spec.IdentityRef) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "identityRef"), c.Spec.IdentityRef, "field is immutable"),
+		)
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlanePlacementGroup, c.Spec.ControlPlanePlacementGroup) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "controlPlanePlacementGroup"), c.Spec.ControlPlanePlacementGroup, "field is immutable"),
+		)
+	}
+
+	// The node security group rules are reconciled, so the IPv6 traffic could be allowed later.
+	if (old.Spec.NodeSecurityGroup == nil) != (c.Spec.NodeSecurityGroup == nil) ||
+		(c.Spec.NodeSecurityGroup != nil && old.Spec.NodeSecurityGroup.Name != c.Spec.NodeSecurityGroup.Name) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "nodeSecurityGroup"), c.Spec.NodeSecurityGroup, "field is immutable"),
+		)
+	}
+
 	// We allow you to change the ControlPlaneEndpoint only if this field has not been set before.
 	// In all other cases, this field is immutable.
 	if !reflect.DeepEqual(c.Spec.ControlPlaneEndpoint, old.Spec.ControlPlaneEndpoint) {
 		if reflect.DeepEqual(old.Spec.ControlPlaneEndpoint, clusterv1.APIEndpoint{}) {
-			allErrs = append(allErrs, isControlPlaneEndpointValid(c.Spec.ControlPlaneEndpoint)...)
+			allErrs = append(allErrs, isControlPlaneEndpointValid(c.Spec.ControlPlaneEndpoint, c.Spec.LoadBalancer.Listener.IPVersion)...)
 		} else {
 			allErrs = append(allErrs,
 				field.Invalid(field.NewPath("spec", "controlPlaneEndpoint"), c.Spec.ControlPlaneEndpoint, "field is immutable"),
@@ -153,19 +172,53 @@ func getAddrType(s string) AddrType {
 	return Unknown
 }
 
-// isIPv4 checks if address is an ipv4 address
-func isIPv4(s string) bool {
-	return getAddrType(s) == IPV4
-}
-
-// isIPv4orFQDN checks if address is an ipv4 address or FQDN
-func isIPv4orFQDN(s string) bool {
+// isIPorFQDN checks if address is an ip address of the given version or FQDN
+func isIPorFQDN(s string, version IPVersion) bool {
 	result := getAddrType(s)
+	if version == IPVersionIPv6 {
+		return result == IPV6 || result == FQDN
+	}
 	return result == IPV4 || result == FQDN
 }
 
-// isControlPlaneEndpointValid checks if ControlPlaneEndpoint has valid fields
-func isControlPlaneEndpointValid(cp clusterv1.APIEndpoint) field.ErrorList {
+// ipVersionName returns the IP version name, IPv4 is used by default.
+func ipVersionName(version IPVersion) string {
+	if version == "" {
+		return string(IPVersionIPv4)
+	}
+	return string(version)
+}
+
+// validateListener checks that the listener address matches the listener IP version.
+// The ALB load balancer supports IPv6 for the external listener only.
+func validateListener(lbs LoadBalancerSpec) field.ErrorList {
+	var errs field.ErrorList
+	path := field.NewPath("spec", "loadBalancer", "listener")
+
+	addrType := IPV4
+	if lbs.Listener.IPVersion == IPVersionIPv6 {
+		addrType = IPV6
+		if lbs.Type != LoadBalancerTypeNLB && lbs.Listener.Internal {
+			errs = append(errs,
+				field.Invalid(path.Child("ipVersion"), lbs.Listener.IPVersion,
+					"IPv6 is supported by the ALB load balancer for the external listener only"),
+			)
+		}
+	}
+
+	if lbs.Listener.Address != "" && getAddrType(lbs.Listener.Address) != addrType {
+		errs = append(errs,
+			field.Invalid(path.Child("address"), lbs.Listener.Address,
+				"field must be a valid "+ipVersionName(lbs.Listener.IPVersion)+" address"),
+		)
+	}
+
+	return errs
+}
+
+// isControlPlaneEndpointValid checks if ControlPlaneEndpoint has valid fields.
+// The endpoint host address has to match the load balancer listener IP version.
+func isControlPlaneEndpointValid(cp clusterv1.APIEndpoint, version IPVersion) field.ErrorList {
 	var errs field.ErrorList
 	if cp.Host == "" || cp.Port == 0 {
 		errs = append(errs,
@@ -173,10 +226,10 @@ func isControlPlaneEndpointValid(cp clusterv1.APIEndpoint) field.ErrorList {
 				cp.Port, "fields have to be not empty"),
 		)
 	}
-	if !isIPv4orFQDN(cp.Host) {
+	if !isIPorFQDN(cp.Host, version) {
 		errs = append(errs,
 			field.Invalid(field.NewPath("spec", "controlPlaneEndpoint", "host"),
-				cp.Host, "field has to be IPv4 or FQDN"),
+				cp.Host, "field has to be "+ipVersionName(version)+" or FQDN"),
 		)
 	}
 
@@ -188,3 +241,117 @@ func isControlPlaneEndpointValid(cp clusterv1.APIEndpoint) field.ErrorList {
 	}
 	return errs
 }
+
+// validateNetwork checks the network specification.
+// Name, subnets and NAT gateway could be set for the managed network only,
+// all subnets which are not set explicitly have to be resolvable from the managed network subnets.
+func validateNetwork(spec YandexClusterSpec) field.ErrorList {
+	var errs field.ErrorList
+	path := field.NewPath("spec", "network")
+
+	if spec.NetworkSpec.ID != "" {
+		if spec.NetworkSpec.Name != "" {
+			errs = append(errs,
+				field.Invalid(path.Child("name"), spec.NetworkSpec.Name, "field could be set for the managed network only"),
+			)
+		}
+		if len(spec.NetworkSpec.Subnets) > 0 {
+			errs = append(errs,
+				field.Invalid(path.Child("subnets"), spec.NetworkSpec.Subnets, "field could be set for the managed network only"),
+			)
+		}
+		if spec.NetworkSpec.NATGateway != nil {
+			errs = append(errs,
+				field.Invalid(path.Child("natGateway"), spec.NetworkSpec.NATGateway, "field could be set for the managed network only"),
+			)
+		}
+	}
+
+	for i, subnet := range spec.NetworkSpec.Subnets {
+		prefix, err := netip.ParsePrefix(subnet.CIDRBlock)
+		if err != nil || !prefix.Addr().Is4() {
+			errs = append(errs,
+				field.Invalid(path.Child("subnets").Index(i).Child("cidrBlock"),
+					subnet.CIDRBlock, "field must be a valid IPv4 CIDR block"),
+			)
+		}
+	}
+
+	if spec.LoadBalancer.Listener.Internal && spec.LoadBalancer.Listener.Subnet.ID == "" &&
+		!hasManagedSubnet(spec, spec.LoadBalancer.Listener.Subnet.ZoneID) {
+		errs = append(errs,
+			field.Invalid(field.NewPath("spec", "loadBalancer", "listener", "subnet"),
+				spec.LoadBalancer.Listener.Subnet, "subnet id has to be set or the managed network has to have a subnet in the zone"),
+		)
+	}
+
+	for i, fd := range spec.FailureDomains {
+		if fd.SubnetID == "" && !hasManagedSubnet(spec, fd.ZoneID) {
+			errs = append(errs,
+				field.Invalid(field.NewPath("spec", "failureDomains").Index(i).Child("subnetID"),
+					fd.SubnetID, "subnet id has to be set or the managed network has to have a subnet in the zone"),
+			)
+		}
+	}
+
+	return errs
+}
+
+// hasManagedSubnet checks that the managed network has a subnet in the availability zone.
+func hasManagedSubnet(spec YandexClusterSpec, zoneID string) bool {
+	if spec.NetworkSpec.ID != "" {
+		return false
+	}
+
+	for _, subnet := range spec.NetworkSpec.Subnets {
+		if subnet.ZoneID == zoneID {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLocations checks the load balancer allocation locations.
+func validateLocations(spec YandexClusterSpec) field.ErrorList {
+	var errs field.ErrorList
+	lbs := spec.LoadBalancer
+	path := field.NewPath("spec", "loadBalancer", "locations")
+
+	if lbs.Type != LoadBalancerTypeALB {
+		return append(errs,
+			field.Invalid(path, lbs.Locations, "field is supported by the application load balancer only"),
+		)
+	}
+
+	zones := make(map[string]struct{}, len(lbs.Locations))
+	listenerSubnetFound := false
+	for i, location := range lbs.Locations {
+		if location.ZoneID == "" || (location.ID == "" && !hasManagedSubnet(spec, location.ZoneID)) {
+			errs = append(errs,
+				field.Invalid(path.Index(i), location, "zoneID and id fields have to be not empty"),
+			)
+		}
+		if _, ok := zones[location.ZoneID]; ok {
+			errs = append(errs,
+				field.Duplicate(path.Index(i).Child("zoneID"), location.ZoneID),
+			)
+		}
+		zones[location.ZoneID] = struct{}{}
+		switch {
+		case location.ID != "" && location.ID == lbs.Listener.Subnet.ID:
+			listenerSubnetFound = true
+		case location.ID == "" && lbs.Listener.Subnet.ID == "" && location.ZoneID == lbs.Listener.Subnet.ZoneID:
+			listenerSubnetFound = true
+		}
+	}
+
+	// Internal listener address is allocated in the listener subnet,
+	// so the load balancer has to be located in this subnet too.
+	if lbs.Listener.Internal && !listenerSubnetFound {
+		errs = append(errs,
+			field.Invalid(path, lbs.Locations, "internal listener subnet has to be one of the load balancer locations"),
+		)
+	}
+
+	return errs
+}
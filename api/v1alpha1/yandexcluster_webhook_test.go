@@ -264,6 +264,213 @@ func TestYandexCluster_ValidateCreate(t *testing.T) {
 					},
 				},
 			},
+			wantErr: false,
+		},
+		{
+			name: "YandexCluster with multi-zone load balancer locations",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Internal: true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "subnet-a",
+							},
+						},
+						Locations: []infrav1.SubnetSpec{
+							{ZoneID: "ru-central1-a", ID: "subnet-a"},
+							{ZoneID: "ru-central1-b", ID: "subnet-b"},
+							{ZoneID: "ru-central1-d", ID: "subnet-d"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "YandexCluster with duplicated load balancer location zones",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Internal: true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "subnet-a",
+							},
+						},
+						Locations: []infrav1.SubnetSpec{
+							{ZoneID: "ru-central1-a", ID: "subnet-a"},
+							{ZoneID: "ru-central1-a", ID: "subnet-a2"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with internal listener subnet missing in load balancer locations",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Internal: true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "subnet-a",
+							},
+						},
+						Locations: []infrav1.SubnetSpec{
+							{ZoneID: "ru-central1-b", ID: "subnet-b"},
+							{ZoneID: "ru-central1-d", ID: "subnet-d"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with network load balancer locations",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeNLB,
+						Listener: infrav1.ListenerSpec{
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "subnet-a",
+							},
+						},
+						Locations: []infrav1.SubnetSpec{
+							{ZoneID: "ru-central1-a", ID: "subnet-a"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with managed network subnets",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: []infrav1.NetworkSubnetSpec{
+							{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+							{ZoneID: "ru-central1-b", CIDRBlock: "10.2.0.0/24"},
+						},
+						NATGateway: &infrav1.NATGatewaySpec{},
+					},
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Internal: true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+							},
+						},
+						Locations: []infrav1.SubnetSpec{
+							{ZoneID: "ru-central1-a"},
+							{ZoneID: "ru-central1-b"},
+						},
+					},
+					FailureDomains: []infrav1.FailureDomainSpec{
+						{ZoneID: "ru-central1-a", ControlPlane: true},
+						{ZoneID: "ru-central1-b", ControlPlane: true},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "YandexCluster with subnets of the existing network",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						ID: "network-id",
+						Subnets: []infrav1.NetworkSubnetSpec{
+							{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+						},
+					},
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with NAT gateway of the existing network",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						ID:         "network-id",
+						NATGateway: &infrav1.NATGatewaySpec{},
+					},
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with invalid managed subnet CIDR block",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: []infrav1.NetworkSubnetSpec{
+							{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.300/24"},
+						},
+					},
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with internal listener zone missing in managed subnets",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: []infrav1.NetworkSubnetSpec{
+							{ZoneID: "ru-central1-b", CIDRBlock: "10.2.0.0/24"},
+						},
+					},
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							Internal: true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+							},
+						},
+					},
+				},
+			},
 			wantErr: true,
 		},
 		{
@@ -302,6 +509,87 @@ func TestYandexCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "YandexCluster with IPv6 address in IPv6 network loadbalancer listener spec",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					ControlPlaneEndpoint: clusterv1.APIEndpoint{
+						Host: "2a02:6b8:a::a",
+						Port: 8443,
+					},
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeNLB,
+						Listener: infrav1.ListenerSpec{
+							Address:   "2a02:6b8:a::a",
+							IPVersion: infrav1.IPVersionIPv6,
+							Internal:  true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "YandexCluster with IPv4 address in IPv6 loadbalancer listener spec",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeNLB,
+						Listener: infrav1.ListenerSpec{
+							Address:   "1.1.1.1",
+							IPVersion: infrav1.IPVersionIPv6,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with IPv6 external application loadbalancer listener spec",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							IPVersion: infrav1.IPVersionIPv6,
+							Internal:  false,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "YandexCluster with IPv6 internal application loadbalancer listener spec",
+			YandexCluster: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					LoadBalancer: infrav1.LoadBalancerSpec{
+						Type: infrav1.LoadBalancerTypeALB,
+						Listener: infrav1.ListenerSpec{
+							IPVersion: infrav1.IPVersionIPv6,
+							Internal:  true,
+							Subnet: infrav1.SubnetSpec{
+								ZoneID: "ru-central1-a",
+								ID:     "some-subnet-id",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "YandexCluster with incorrect IP address in loadbalancer listener spec",
 			YandexCluster: &infrav1.YandexCluster{
@@ -445,6 +733,95 @@ func TestYandexCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "YandexCluster with changes in immutable field network",
+			newTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: []infrav1.NetworkSubnetSpec{
+							{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+							{ZoneID: "ru-central1-b", CIDRBlock: "10.2.0.0/24"},
+						},
+					},
+				},
+			},
+			oldTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: []infrav1.NetworkSubnetSpec{
+							{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with changes in immutable field identityRef",
+			newTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					IdentityRef: &infrav1.IdentityReference{Name: "new-identity"},
+				},
+			},
+			oldTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					IdentityRef: &infrav1.IdentityReference{Name: "old-identity"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with changes in immutable field controlPlanePlacementGroup",
+			newTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					ControlPlanePlacementGroup: &infrav1.PlacementGroupSpec{},
+				},
+			},
+			oldTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with changes in immutable field nodeSecurityGroup",
+			newTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NodeSecurityGroup: &infrav1.SecurityGroupSpec{Name: "nodes"},
+				},
+			},
+			oldTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NodeSecurityGroup: &infrav1.SecurityGroupSpec{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "YandexCluster with IPv6 allowed in nodeSecurityGroup",
+			newTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NodeSecurityGroup: &infrav1.SecurityGroupSpec{Name: "nodes", IPv6: true},
+				},
+			},
+			oldTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NodeSecurityGroup: &infrav1.SecurityGroupSpec{Name: "nodes"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "YandexCluster with removed nodeSecurityGroup",
+			newTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{},
+			},
+			oldTemplate: &infrav1.YandexCluster{
+				Spec: infrav1.YandexClusterSpec{
+					NodeSecurityGroup: &infrav1.SecurityGroupSpec{},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "YandexCluster with changes in empty field controlPlaneEndpoint",
 			newTemplate: &infrav1.YandexCluster{
@@ -0,0 +1,99 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// YandexClusterIdentityKind is the kind of the YandexClusterIdentity object.
+	YandexClusterIdentityKind = "YandexClusterIdentity"
+
+	// IdentitySecretKey is the key of the identity secret data containing the service account authorized key.
+	IdentitySecretKey = "key"
+)
+
+// YandexClusterIdentitySpec defines the desired state of YandexClusterIdentity.
+type YandexClusterIdentitySpec struct {
+	// SecretRef is the reference to the Secret holding the YandexCloud service account authorized key
+	// in the JSON format under the "key" data key.
+	// More information https://yandex.cloud/ru/docs/iam/concepts/authorization/key .
+	SecretRef SecretReference `json:"secretRef"`
+
+	// AllowedNamespaces is used to identify the namespaces the YandexClusters are allowed to use the identity from.
+	// Namespaces can be selected either using an array of namespaces or with label selector.
+	// An empty allowedNamespaces object indicates that YandexClusters can use this identity from any namespace.
+	// If this object is nil, no namespaces will be allowed.
+	// +optional
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// SecretReference is the reference to a Secret in a namespace.
+type SecretReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// AllowedNamespaces defines the namespaces allowed to use an identity.
+type AllowedNamespaces struct {
+	// NamespaceList is a list of namespaces allowed to use the identity.
+	// +optional
+	NamespaceList []string `json:"list,omitempty"`
+
+	// Selector is a label selector of the namespaces allowed to use the identity.
+	// An empty selector matches no namespaces.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// IdentityReference is the reference to the YandexClusterIdentity used to access YandexCloud.
+type IdentityReference struct {
+	// Name is the name of the YandexClusterIdentity.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+
+// YandexClusterIdentity is the Schema for the yandexclusteridentities API.
+// It holds the credentials of the YandexCloud service account used to manage the clusters.
+type YandexClusterIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec YandexClusterIdentitySpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// YandexClusterIdentityList contains a list of YandexClusterIdentity.
+type YandexClusterIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YandexClusterIdentity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&YandexClusterIdentity{}, &YandexClusterIdentityList{})
+}
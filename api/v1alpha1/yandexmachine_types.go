@@ -29,6 +29,37 @@ const (
 	// MachineFinalizer allows cleaning up resources associated with
 	// YandexMachine before removing it from the apiserver.
 	MachineFinalizer = "yandexmachine.infrastructure.cluster.x-k8s.io"
+
+	// InPlaceResizeAnnotation set to "true" allows to change the YandexMachine platform and resources in place.
+	// The instance is stopped, updated and started again instead of the machine replacement.
+	InPlaceResizeAnnotation = "yandexmachine.infrastructure.cluster.x-k8s.io/in-place-resize"
+
+	// DiskTypeLocal is the type of the secondary disk located on the host of YandexCloud VM.
+	DiskTypeLocal = "local"
+
+	// DiskTypeNonReplicated is the type of the non-replicated network SSD disk.
+	DiskTypeNonReplicated = "network-ssd-nonreplicated"
+
+	// DiskTypeIOM3 is the type of the ultra high-speed network storage with three replicas.
+	DiskTypeIOM3 = "network-ssd-io-m3"
+
+	// MetadataKeyUserData is the YandexCloud VM metadata key of the bootstrap data.
+	MetadataKeyUserData = "user-data"
+
+	// MetadataKeySSHKeys is the YandexCloud VM metadata key of the SSH public keys.
+	MetadataKeySSHKeys = "ssh-keys"
+)
+
+// BootstrapFormat is the format of the machine bootstrap data.
+// +kubebuilder:validation:Enum=cloud-config;ignition
+type BootstrapFormat string
+
+const (
+	// BootstrapFormatCloudConfig is the cloud-init configuration format.
+	BootstrapFormatCloudConfig BootstrapFormat = "cloud-config"
+
+	// BootstrapFormatIgnition is the Ignition configuration format of Flatcar and Fedora CoreOS images.
+	BootstrapFormatIgnition BootstrapFormat = "ignition"
 )
 
 //+kubebuilder:validation:Required
@@ -39,8 +70,9 @@ type YandexMachineSpec struct {
 	ProviderID *string `json:"providerID,omitempty"`
 
 	// ZoneID is the identifier of YandexCloud availability zone.
+	// If ZoneID not provided, the zone is taken from the Machine failure domain,
+	// or set to the ru-central1-d, if the failure domain is not set too.
 	// +optional
-	// +kubebuilder:default=ru-central1-d
 	ZoneID *string `json:"zoneID,omitempty"`
 
 	// PlatformID is the identifier of YandexCloud current CPU model.
@@ -54,22 +86,139 @@ type YandexMachineSpec struct {
 	// Disk is boot storage configuration for YandexCloud VM.
 	BootDisk *Disk `json:"bootDisk"`
 
+	// SecondaryDisks is a list of additional disks attached to YandexCloud VM.
+	// +optional
+	SecondaryDisks []SecondaryDisk `json:"secondaryDisks,omitempty"`
+
 	// Resources contains computing resources of YandexCloud VM.
 	Resources Resources `json:"resources"`
 
 	// NetworkInterfaces is a network interfaces configurations for YandexCloud VM
 	NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+
+	// ServiceAccountID is the identifier of the service account attached to YandexCloud VM.
+	// The VM gets the IAM token of the service account from the metadata service,
+	// for example, to pull images from Container Registry without secrets.
+	// More information https://yandex.cloud/ru/docs/compute/operations/vm-connect/auth-inside-vm .
+	// +optional
+	ServiceAccountID string `json:"serviceAccountID,omitempty"`
+
+	// PlacementGroupID is the identifier of the existing placement group to place YandexCloud VM in.
+	// If PlacementGroupID not provided, the control plane machines are placed in the control plane
+	// placement group managed by the provider, if it is enabled in the YandexCluster.
+	// More information https://yandex.cloud/ru/docs/compute/concepts/placement-groups .
+	// +optional
+	PlacementGroupID string `json:"placementGroupID,omitempty"`
+
+	// HostGroupID is the identifier of the existing dedicated host group to place YandexCloud VM on.
+	// HostGroupID is required for the local secondary disks, which are available on the dedicated hosts only.
+	// Not supported by YandexMachinePool.
+	// More information https://yandex.cloud/ru/docs/compute/concepts/dedicated-host .
+	// +optional
+	HostGroupID string `json:"hostGroupID,omitempty"`
+
+	// BootstrapFormat is the format of the bootstrap data the VM OS image expects:
+	// cloud-config for the cloud-init images or ignition for Flatcar and Fedora CoreOS images.
+	// If BootstrapFormat not provided, the format is taken from the bootstrap data secret,
+	// otherwise the format of the secret must match it.
+	// +optional
+	BootstrapFormat BootstrapFormat `json:"bootstrapFormat,omitempty"`
+
+	// Metadata is the additional metadata of YandexCloud VM, for example
+	// serial-port-enable, enable-oslogin or the settings of the agents running on the VM.
+	// The reserved keys user-data and ssh-keys are set by the provider and could not be overwritten.
+	// More information https://yandex.cloud/ru/docs/compute/concepts/vm-metadata .
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// SSHKeys is a list of SSH public keys authorized on YandexCloud VM.
+	// The keys are passed in the ssh-keys metadata key.
+	// +optional
+	SSHKeys []SSHKey `json:"sshKeys,omitempty"`
+
+	// RestoreDrift is set to true to re-apply the YandexCloud VM labels and metadata,
+	// if they are changed outside of the provider. The other fields changed outside of the provider,
+	// like resources or platform, are only reported in the SpecInSync condition.
+	// RestoreDrift is ignored by YandexMachinePool, the instance group keeps its instances in sync by itself.
+	// +optional
+	RestoreDrift bool `json:"restoreDrift,omitempty"`
+
+	// Preemptible is set to true if YandexCloud VM should be preemptible.
+	// Preemptible VMs are cheaper, but are stopped at least once every 24 hours
+	// and can be stopped at any time if their resources are needed by Compute.
+	// A stopped preemptible VM is reported as a failed machine to be replaced.
+	// More information https://yandex.cloud/ru/docs/compute/concepts/preemptible-vm .
+	// +optional
+	// +kubebuilder:default=false
+	Preemptible *bool `json:"preemptible,omitempty"`
 }
 
 // NetworkInterface defines the network interface configuration of YandexCloud VM.
 type NetworkInterface struct {
 	// SubnetID is the identifier of subnetwork to use for this instance.
-	SubnetID string `json:"subnetID"`
+	// If SubnetID not provided, the subnet of the Machine failure domain is used.
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// Address is the static internal IPv4 address of the network interface.
+	// The address must belong to the network interface subnet.
+	// If neither Address nor AddressFromPool provided, the address is allocated automatically.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// AddressFromPool is a reference to the Cluster API IPAM pool the internal IPv4 address
+	// of the network interface is claimed from. The IPAddressClaim is created for the YandexMachine
+	// and released together with it.
+	// Only one of Address and AddressFromPool could be set.
+	// +optional
+	AddressFromPool *corev1.TypedLocalObjectReference `json:"addressFromPool,omitempty"`
 
 	// HasPublicIP is set to true if public IP for YandexCloud VM is needed.
 	// +optional
 	// +kubebuilder:default=false
 	HasPublicIP *bool `json:"hasPublicIP,omitempty"`
+
+	// PublicAddressID is the identifier of the reserved public IPv4 address used for the network interface.
+	// It could be set only if HasPublicIP is true, otherwise an ephemeral public address is allocated.
+	// More information https://yandex.cloud/ru/docs/vpc/concepts/address.
+	// +optional
+	PublicAddressID string `json:"publicAddressID,omitempty"`
+
+	// HasIPv6 is set to true if the network interface has to get an internal IPv6 address.
+	// The subnet of the network interface must be a dual-stack subnet.
+	// +optional
+	// +kubebuilder:default=false
+	HasIPv6 *bool `json:"hasIPv6,omitempty"`
+
+	// SecurityGroupIDs is a list of the security group identifiers for the network interface.
+	// If SecurityGroupIDs not provided and the cluster node security group is not managed,
+	// the default security group of the network is used.
+	// More information https://yandex.cloud/ru/docs/vpc/concepts/security-groups.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+
+	// LoadBalancerTarget is set to true for the network interface, which internal address
+	// is registered in the control plane load balancer target group.
+	// Only one network interface could be the load balancer target.
+	// If no network interface is marked, the first one is used.
+	// +optional
+	LoadBalancerTarget bool `json:"loadBalancerTarget,omitempty"`
+}
+
+// SSHKey defines the SSH public key authorized on YandexCloud VM.
+type SSHKey struct {
+	// User is the name of the VM user the key is authorized for.
+	User string `json:"user"`
+
+	// PublicKey is the SSH public key, for example "ssh-ed25519 AAAA... user@host".
+	// Only one of PublicKey and PublicKeySecretRef could be set.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// PublicKeySecretRef is a reference to the key of the Secret in the YandexMachine namespace,
+	// which contains the SSH public key.
+	// +optional
+	PublicKeySecretRef *corev1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
 }
 
 // Resources defines the YandexCloud VM resources, like cores, memory etc.
@@ -111,7 +260,79 @@ type Disk struct {
 	Size resource.Quantity `json:"size"`
 
 	// ImageID is the identifier for OS image of YandexCloud VM.
-	ImageID string `json:"imageID"`
+	// Either ImageID or ImageFamily must be set.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// ImageFamily is the family of OS images of YandexCloud VM.
+	// It is resolved to the latest image of the family when the VM is created.
+	// +optional
+	ImageFamily *ImageFamily `json:"imageFamily,omitempty"`
+}
+
+// ImageFamily defines the YandexCloud image family reference.
+type ImageFamily struct {
+	// Name is the image family name.
+	Name string `json:"name"`
+
+	// FolderID is the identifier of the folder the images of the family are located in.
+	// If FolderID not provided, the folder of the cluster is used.
+	// The public images are located in the standard-images folder.
+	// +optional
+	FolderID string `json:"folderID,omitempty"`
+}
+
+// SecondaryDisk defines YandexCloud VM secondary disk configuration.
+type SecondaryDisk struct {
+	// TypeID is the disk storage type for YandexCloud VM
+	// Possible values: network-ssd, network-hdd, network-ssd-nonreplicated, network-ssd-io-m3, local.
+	// Local disks are located on the host of the VM and are available on dedicated hosts only,
+	// they can not be created from an image or a snapshot and are always deleted with the VM.
+	// More information https://cloud.yandex.ru/ru/docs/compute/concepts/disk .
+	// +optional
+	// +kubebuilder:default=network-ssd
+	TypeID *string `json:"typeID,omitempty"`
+
+	// Size is the disk size in bytes
+	// Allows to specify k,M,G... or Ki,Mi,Gi... suffixes
+	// The size of network-ssd-nonreplicated disk must be a multiple of 93Gi.
+	// For more information see https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity .
+	Size resource.Quantity `json:"size"`
+
+	// ImageID is the identifier of the image to create the disk from.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// SnapshotID is the identifier of the snapshot to create the disk from.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// DeviceName is the serial number of the disk, the disk is available
+	// in the VM as /dev/disk/by-id/virtio-<DeviceName>.
+	// If DeviceName not provided, it is generated by YandexCloud. It can not be set for the local disks.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// AutoDelete is set to true if the disk should be deleted with YandexCloud VM.
+	// The disks of YandexMachinePool instances are always deleted, so it can not be set to false there.
+	// +optional
+	// +kubebuilder:default=true
+	AutoDelete *bool `json:"autoDelete,omitempty"`
+}
+
+// DiskStatus describes the disk attached to YandexCloud VM.
+type DiskStatus struct {
+	// ID is the identifier of the disk, empty for local disks.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// DeviceName is the serial number of the disk in YandexCloud VM.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// Local is true for the local disk of YandexCloud VM.
+	// +optional
+	Local bool `json:"local,omitempty"`
 }
 
 // YandexMachineStatus defines the observed state of YandexMachine.
@@ -121,6 +342,8 @@ type YandexMachineStatus struct {
 	Ready bool `json:"ready"`
 
 	// Addresses contains the YandexCloud instance associated addresses.
+	// The internal addresses of all network interfaces are listed in the network interfaces order
+	// followed by their external addresses.
 	// +optional
 	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
 
@@ -128,6 +351,19 @@ type YandexMachineStatus struct {
 	// +optional
 	InstanceStatus *InstanceStatus `json:"instanceState,omitempty"`
 
+	// BootImageID is the identifier of the OS image the YandexCloud instance boot disk is created from.
+	// +optional
+	BootImageID string `json:"bootImageID,omitempty"`
+
+	// SecondaryDisks contains the secondary disks attached to the YandexCloud instance.
+	// +optional
+	SecondaryDisks []DiskStatus `json:"secondaryDisks,omitempty"`
+
+	// BootstrapDataObject is the object storage key of the uploaded bootstrap data.
+	// It is cleared when the object is deleted after the node has joined the cluster.
+	// +optional
+	BootstrapDataObject string `json:"bootstrapDataObject,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -18,9 +18,12 @@ package v1alpha1
 
 import (
 	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,6 +35,21 @@ import (
 // log is for logging in this package.
 var log = logf.Log.WithName("yandexmachine-resource")
 
+// resourceIDRegex is the YandexCloud resource identifier.
+var resourceIDRegex = regexp.MustCompile("^[a-z][a-z0-9]{19}$")
+
+// userNameRegex is the name of the Linux user the SSH key is authorized for.
+var userNameRegex = regexp.MustCompile("^[a-z_][a-z0-9_-]{0,31}$")
+
+// ignitionImageFamilyPrefixes are the image families of the OS configured with Ignition only.
+var ignitionImageFamilyPrefixes = []string{"flatcar", "fedora-coreos"}
+
+// cloudInitImageFamilyPrefixes are the image families of the OS configured with cloud-init only.
+var cloudInitImageFamilyPrefixes = []string{"ubuntu", "debian", "centos", "almalinux", "rocky"}
+
+// reservedMetadataKeys are the YandexCloud VM metadata keys set by the provider.
+var reservedMetadataKeys = []string{MetadataKeyUserData, MetadataKeySSHKeys}
+
 // SetupWebhookWithManager creates an YandexMachine validation webhook.
 func (ym *YandexMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
@@ -55,7 +73,22 @@ func (ym *YandexMachine) Default() {
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (ym *YandexMachine) ValidateCreate() (admission.Warnings, error) {
 	log.Info("validate create", "name", ym.Name)
-	return nil, nil
+
+	allErrs := validateBootDisk(field.NewPath("spec", "bootDisk"), ym.Spec.BootDisk)
+	allErrs = append(allErrs, validateSecondaryDisks(field.NewPath("spec", "secondaryDisks"), ym.Spec.SecondaryDisks)...)
+	allErrs = append(allErrs, validateHostGroupID(
+		field.NewPath("spec", "hostGroupID"), ym.Spec.HostGroupID, ym.Spec.SecondaryDisks)...)
+	allErrs = append(allErrs, validateServiceAccountID(field.NewPath("spec", "serviceAccountID"), ym.Spec.ServiceAccountID)...)
+	allErrs = append(allErrs, validateNetworkInterfaces(field.NewPath("spec", "networkInterfaces"), ym.Spec.NetworkInterfaces)...)
+	allErrs = append(allErrs, validateMetadata(field.NewPath("spec", "metadata"), ym.Spec.Metadata)...)
+	allErrs = append(allErrs, validateSSHKeys(field.NewPath("spec", "sshKeys"), ym.Spec.SSHKeys)...)
+	allErrs = append(allErrs, validateBootstrapFormat(
+		field.NewPath("spec", "bootstrapFormat"), ym.Spec.BootstrapFormat, ym.Spec.BootDisk)...)
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(GroupVersion.WithKind("YandexMachine").GroupKind(), ym.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
@@ -82,6 +115,14 @@ func (ym *YandexMachine) ValidateUpdate(oldRaw runtime.Object) (admission.Warnin
 	delete(oldYandexMachineSpec, "providerID")
 	delete(newYandexMachineSpec, "providerID")
 
+	// allow changes to the instance platform and resources, if the in-place resize is enabled.
+	if ym.GetAnnotations()[InPlaceResizeAnnotation] == "true" {
+		for _, key := range []string{"platformID", "resources"} {
+			delete(oldYandexMachineSpec, key)
+			delete(newYandexMachineSpec, key)
+		}
+	}
+
 	if !reflect.DeepEqual(oldYandexMachineSpec, newYandexMachineSpec) {
 		return nil, apierrors.NewInvalid(GroupVersion.WithKind("YandexMachine").GroupKind(), ym.Name, field.ErrorList{
 			field.Forbidden(field.NewPath("spec"), "cannot be modified"),
@@ -96,3 +137,249 @@ func (ym *YandexMachine) ValidateDelete() (admission.Warnings, error) {
 	log.Info("validate delete", "name", ym.Name)
 	return nil, nil
 }
+
+// validateNetworkInterfaces validates the YandexCloud VM network interfaces.
+func validateNetworkInterfaces(path *field.Path, interfaces []NetworkInterface) field.ErrorList {
+	var allErrs field.ErrorList
+
+	targets := 0
+	for i, networkInterface := range interfaces {
+		allErrs = append(allErrs, validateNetworkInterfaceAddresses(path.Index(i), networkInterface)...)
+
+		if !networkInterface.LoadBalancerTarget {
+			continue
+		}
+		targets++
+		if targets > 1 {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("loadBalancerTarget"), true,
+				"only one network interface could be the load balancer target"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNetworkInterfaceAddresses validates the static and the pool addresses of the network interface.
+func validateNetworkInterfaceAddresses(path *field.Path, networkInterface NetworkInterface) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if networkInterface.Address != "" {
+		if getAddrType(networkInterface.Address) != IPV4 {
+			allErrs = append(allErrs, field.Invalid(path.Child("address"), networkInterface.Address,
+				"field must be a valid IPv4 address"))
+		}
+		if networkInterface.AddressFromPool != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("addressFromPool"), networkInterface.AddressFromPool,
+				"only one of address and addressFromPool could be set"))
+		}
+	}
+
+	if pool := networkInterface.AddressFromPool; pool != nil {
+		if pool.Name == "" || pool.Kind == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("addressFromPool"), pool,
+				"pool kind and name have to be set"))
+		}
+		// IPAddressClaim requires the pool API group, so the claim could not be created without it.
+		if pool.APIGroup == nil || *pool.APIGroup == "" {
+			allErrs = append(allErrs, field.Required(path.Child("addressFromPool", "apiGroup"),
+				"pool API group has to be set"))
+		}
+	}
+
+	if networkInterface.PublicAddressID != "" {
+		allErrs = append(allErrs, validateResourceID(path.Child("publicAddressID"), networkInterface.PublicAddressID)...)
+		if networkInterface.HasPublicIP == nil || !*networkInterface.HasPublicIP {
+			allErrs = append(allErrs, field.Invalid(path.Child("publicAddressID"), networkInterface.PublicAddressID,
+				"reserved public address could be set only if hasPublicIP is true"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMetadata validates the additional metadata of YandexCloud VM.
+func validateMetadata(path *field.Path, metadata map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, key := range reservedMetadataKeys {
+		if _, ok := metadata[key]; ok {
+			allErrs = append(allErrs, field.Forbidden(path.Key(key), "metadata key is reserved by the provider"))
+		}
+	}
+	if _, ok := metadata[""]; ok {
+		allErrs = append(allErrs, field.Invalid(path, "", "metadata key must not be empty"))
+	}
+
+	return allErrs
+}
+
+// validateSSHKeys validates the SSH public keys authorized on YandexCloud VM.
+func validateSSHKeys(path *field.Path, keys []SSHKey) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, key := range keys {
+		keyPath := path.Index(i)
+		if !userNameRegex.MatchString(key.User) {
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("user"), key.User,
+				"must be a valid user name of lowercase Latin letters, digits, underscores and hyphens"))
+		}
+
+		switch {
+		case key.PublicKey == "" && key.PublicKeySecretRef == nil:
+			allErrs = append(allErrs, field.Required(keyPath.Child("publicKey"),
+				"either publicKey or publicKeySecretRef must be set"))
+		case key.PublicKey != "" && key.PublicKeySecretRef != nil:
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("publicKeySecretRef"), key.PublicKeySecretRef.Name,
+				"only one of publicKey and publicKeySecretRef can be set"))
+		case key.PublicKeySecretRef != nil && (key.PublicKeySecretRef.Name == "" || key.PublicKeySecretRef.Key == ""):
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("publicKeySecretRef"), key.PublicKeySecretRef,
+				"secret name and key have to be set"))
+		case strings.ContainsAny(key.PublicKey, "\n\r"):
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("publicKey"), key.PublicKey,
+				"public key must be a single line"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateBootstrapFormat validates the declared bootstrap format matches the boot disk image family.
+// The image referenced by the identifier can not be checked, so only the well-known image families are validated.
+func validateBootstrapFormat(path *field.Path, format BootstrapFormat, disk *Disk) field.ErrorList {
+	if format == "" || disk == nil || disk.ImageFamily == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	family := disk.ImageFamily.Name
+	switch {
+	case format != BootstrapFormatIgnition && hasAnyPrefix(family, ignitionImageFamilyPrefixes):
+		allErrs = append(allErrs, field.Invalid(path, format,
+			"image family "+family+" supports ignition bootstrap format only"))
+	case format != BootstrapFormatCloudConfig && hasAnyPrefix(family, cloudInitImageFamilyPrefixes):
+		allErrs = append(allErrs, field.Invalid(path, format,
+			"image family "+family+" supports cloud-config bootstrap format only"))
+	}
+
+	return allErrs
+}
+
+// hasAnyPrefix returns true if the string starts with any of the prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateServiceAccountID validates the identifier of the service account attached to YandexCloud VM.
+func validateServiceAccountID(path *field.Path, id string) field.ErrorList {
+	if id == "" {
+		return nil
+	}
+	return validateResourceID(path, id)
+}
+
+// validateResourceID validates the YandexCloud resource identifier.
+func validateResourceID(path *field.Path, id string) field.ErrorList {
+	var allErrs field.ErrorList
+	if !resourceIDRegex.MatchString(id) {
+		allErrs = append(allErrs, field.Invalid(path, id,
+			"must be a YandexCloud resource identifier of 20 lowercase Latin letters and digits, starting with a letter"))
+	}
+
+	return allErrs
+}
+
+// validateBootDisk validates the YandexCloud VM boot disk image reference.
+func validateBootDisk(path *field.Path, disk *Disk) field.ErrorList {
+	var allErrs field.ErrorList
+	if disk == nil {
+		return allErrs
+	}
+
+	switch {
+	case disk.ImageID == "" && disk.ImageFamily == nil:
+		allErrs = append(allErrs, field.Required(path.Child("imageID"), "either imageID or imageFamily must be set"))
+	case disk.ImageID != "" && disk.ImageFamily != nil:
+		allErrs = append(allErrs, field.Invalid(path.Child("imageFamily"), disk.ImageFamily.Name,
+			"only one of imageID and imageFamily can be set"))
+	case disk.ImageFamily != nil && disk.ImageFamily.Name == "":
+		allErrs = append(allErrs, field.Required(path.Child("imageFamily", "name"), "image family name must be set"))
+	}
+
+	return allErrs
+}
+
+// nonReplicatedDiskSizeUnit is the allocation unit of the network-ssd-nonreplicated and network-ssd-io-m3 disks.
+var nonReplicatedDiskSizeUnit = resource.MustParse("93Gi")
+
+// validateSecondaryDisks validates the YandexCloud VM secondary disks.
+func validateSecondaryDisks(path *field.Path, disks []SecondaryDisk) field.ErrorList {
+	var allErrs field.ErrorList
+
+	deviceNames := make(map[string]struct{})
+	for i, disk := range disks {
+		diskPath := path.Index(i)
+
+		if disk.ImageID != "" && disk.SnapshotID != "" {
+			allErrs = append(allErrs, field.Invalid(diskPath.Child("snapshotID"), disk.SnapshotID,
+				"only one of imageID and snapshotID can be set"))
+		}
+
+		typeID := ""
+		if disk.TypeID != nil {
+			typeID = *disk.TypeID
+		}
+		switch typeID {
+		case DiskTypeLocal:
+			if disk.ImageID != "" || disk.SnapshotID != "" {
+				allErrs = append(allErrs, field.Forbidden(diskPath, "local disk cannot be created from an image or a snapshot"))
+			}
+			if disk.AutoDelete != nil && !*disk.AutoDelete {
+				allErrs = append(allErrs, field.Invalid(diskPath.Child("autoDelete"), *disk.AutoDelete,
+					"local disk is always deleted with the instance"))
+			}
+			if disk.DeviceName != "" {
+				allErrs = append(allErrs, field.Forbidden(diskPath.Child("deviceName"),
+					"device name cannot be set for local disk"))
+			}
+		case DiskTypeNonReplicated, DiskTypeIOM3:
+			if disk.Size.Value()%nonReplicatedDiskSizeUnit.Value() != 0 {
+				allErrs = append(allErrs, field.Invalid(diskPath.Child("size"), disk.Size.String(),
+					"size of "+typeID+" disk must be a multiple of 93Gi"))
+			}
+		}
+
+		if disk.DeviceName == "" {
+			continue
+		}
+		if _, ok := deviceNames[disk.DeviceName]; ok {
+			allErrs = append(allErrs, field.Duplicate(diskPath.Child("deviceName"), disk.DeviceName))
+		}
+		deviceNames[disk.DeviceName] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// validateHostGroupID validates the dedicated host group of YandexCloud VM.
+// The local disks are available on the dedicated hosts only, so the host group is required for them.
+func validateHostGroupID(path *field.Path, hostGroupID string, disks []SecondaryDisk) field.ErrorList {
+	if hostGroupID != "" {
+		return validateResourceID(path, hostGroupID)
+	}
+
+	var allErrs field.ErrorList
+	for _, disk := range disks {
+		if disk.TypeID != nil && *disk.TypeID == DiskTypeLocal {
+			allErrs = append(allErrs, field.Required(path, "local disks are available on dedicated hosts only"))
+			break
+		}
+	}
+
+	return allErrs
+}
@@ -22,6 +22,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
@@ -70,6 +72,64 @@ func TestYandexMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in resources without in-place resize annotation",
+			oldMachine: &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{Name: "ym-test"},
+				Spec: infrav1.YandexMachineSpec{
+					Resources: infrav1.Resources{Cores: 2},
+				},
+			},
+			newMachine: &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{Name: "ym-test"},
+				Spec: infrav1.YandexMachineSpec{
+					Resources: infrav1.Resources{Cores: 4},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "change in platform and resources with in-place resize annotation",
+			oldMachine: &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{Name: "ym-test"},
+				Spec: infrav1.YandexMachineSpec{
+					PlatformID: ptr.To("standard-v2"),
+					Resources:  infrav1.Resources{Cores: 2},
+				},
+			},
+			newMachine: &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{
+					Name:        "ym-test",
+					Annotations: map[string]string{infrav1.InPlaceResizeAnnotation: "true"},
+				},
+				Spec: infrav1.YandexMachineSpec{
+					PlatformID: ptr.To("standard-v3"),
+					Resources:  infrav1.Resources{Cores: 4},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in immutable fields with in-place resize annotation",
+			oldMachine: &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{Name: "ym-test"},
+				Spec: infrav1.YandexMachineSpec{
+					ZoneID:    ptr.To("ru-central1-a"),
+					Resources: infrav1.Resources{Cores: 2},
+				},
+			},
+			newMachine: &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{
+					Name:        "ym-test",
+					Annotations: map[string]string{infrav1.InPlaceResizeAnnotation: "true"},
+				},
+				Spec: infrav1.YandexMachineSpec{
+					ZoneID:    ptr.To("ru-central1-b"),
+					Resources: infrav1.Resources{Cores: 4},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -85,3 +145,287 @@ func TestYandexMachine_ValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestYandexMachine_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name             string
+		bootDisk         *infrav1.Disk
+		disks            []infrav1.SecondaryDisk
+		hostGroupID      string
+		serviceAccountID string
+		interfaces       []infrav1.NetworkInterface
+		metadata         map[string]string
+		sshKeys          []infrav1.SSHKey
+		bootstrapFormat  infrav1.BootstrapFormat
+		wantErr          bool
+	}{
+		{
+			name: "one load balancer target interface",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a"},
+				{SubnetID: "subnet-b", LoadBalancerTarget: true},
+			},
+			wantErr: false,
+		},
+		{
+			name: "several load balancer target interfaces",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a", LoadBalancerTarget: true},
+				{SubnetID: "subnet-b", LoadBalancerTarget: true},
+			},
+			wantErr: true,
+		},
+		{
+			name: "static and reserved public addresses",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a", Address: "10.0.0.10", HasPublicIP: ptr.To(true), PublicAddressID: "e9babcdefgh123456789"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid static address",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a", Address: "2a02:6b8:c0::10"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "static address and address from pool",
+			interfaces: []infrav1.NetworkInterface{
+				{
+					SubnetID:        "subnet-a",
+					Address:         "10.0.0.10",
+					AddressFromPool: &corev1.TypedLocalObjectReference{Kind: "InClusterIPPool", Name: "pool"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "address from pool",
+			interfaces: []infrav1.NetworkInterface{
+				{
+					SubnetID: "subnet-a",
+					AddressFromPool: &corev1.TypedLocalObjectReference{
+						APIGroup: ptr.To("ipam.cluster.x-k8s.io"), Kind: "InClusterIPPool", Name: "pool",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "address from pool without api group",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a", AddressFromPool: &corev1.TypedLocalObjectReference{Kind: "InClusterIPPool", Name: "pool"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "address from pool without name",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a", AddressFromPool: &corev1.TypedLocalObjectReference{Kind: "InClusterIPPool"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved public address without public ip",
+			interfaces: []infrav1.NetworkInterface{
+				{SubnetID: "subnet-a", PublicAddressID: "e9babcdefgh123456789"},
+			},
+			wantErr: true,
+		},
+		{
+			name:             "valid service account",
+			serviceAccountID: "ajeabcdefgh123456789",
+			wantErr:          false,
+		},
+		{
+			name:             "invalid service account",
+			serviceAccountID: "service-account",
+			wantErr:          true,
+		},
+		{
+			name:            "ignition format for flatcar image family",
+			bootDisk:        &infrav1.Disk{ImageFamily: &infrav1.ImageFamily{Name: "flatcar-stable"}},
+			bootstrapFormat: infrav1.BootstrapFormatIgnition,
+			wantErr:         false,
+		},
+		{
+			name:            "cloud-config format for flatcar image family",
+			bootDisk:        &infrav1.Disk{ImageFamily: &infrav1.ImageFamily{Name: "flatcar-stable"}},
+			bootstrapFormat: infrav1.BootstrapFormatCloudConfig,
+			wantErr:         true,
+		},
+		{
+			name:            "ignition format for ubuntu image family",
+			bootDisk:        &infrav1.Disk{ImageFamily: &infrav1.ImageFamily{Name: "ubuntu-2204-lts"}},
+			bootstrapFormat: infrav1.BootstrapFormatIgnition,
+			wantErr:         true,
+		},
+		{
+			name:            "ignition format for image identifier",
+			bootDisk:        &infrav1.Disk{ImageID: "image-id"},
+			bootstrapFormat: infrav1.BootstrapFormatIgnition,
+			wantErr:         false,
+		},
+		{
+			name:     "custom metadata",
+			metadata: map[string]string{"serial-port-enable": "1", "enable-oslogin": "true"},
+			wantErr:  false,
+		},
+		{
+			name:     "reserved user-data metadata key",
+			metadata: map[string]string{"user-data": "#cloud-config"},
+			wantErr:  true,
+		},
+		{
+			name:     "reserved ssh-keys metadata key",
+			metadata: map[string]string{"ssh-keys": "ubuntu:ssh-ed25519 AAAA"},
+			wantErr:  true,
+		},
+		{
+			name: "valid ssh keys",
+			sshKeys: []infrav1.SSHKey{
+				{User: "ubuntu", PublicKey: "ssh-ed25519 AAAA user@host"},
+				{User: "admin", PublicKeySecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "ssh-keys"},
+					Key:                  "admin.pub",
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "ssh key without public key",
+			sshKeys: []infrav1.SSHKey{{User: "ubuntu"}},
+			wantErr: true,
+		},
+		{
+			name:    "ssh key with invalid user",
+			sshKeys: []infrav1.SSHKey{{User: "Ubuntu:root", PublicKey: "ssh-ed25519 AAAA"}},
+			wantErr: true,
+		},
+		{
+			name: "ssh key with both public key and secret",
+			sshKeys: []infrav1.SSHKey{{
+				User:               "ubuntu",
+				PublicKey:          "ssh-ed25519 AAAA",
+				PublicKeySecretRef: &corev1.SecretKeySelector{Key: "key"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "multiline ssh key",
+			sshKeys: []infrav1.SSHKey{{User: "ubuntu", PublicKey: "ssh-ed25519 AAAA\nroot:ssh-ed25519 BBBB"}},
+			wantErr: true,
+		},
+		{
+			name:     "boot disk from image family",
+			bootDisk: &infrav1.Disk{ImageFamily: &infrav1.ImageFamily{Name: "ubuntu-2204-lts", FolderID: "standard-images"}},
+			wantErr:  false,
+		},
+		{
+			name:     "boot disk without image",
+			bootDisk: &infrav1.Disk{},
+			wantErr:  true,
+		},
+		{
+			name:     "boot disk with both image and image family",
+			bootDisk: &infrav1.Disk{ImageID: "image-id", ImageFamily: &infrav1.ImageFamily{Name: "ubuntu-2204-lts"}},
+			wantErr:  true,
+		},
+		{
+			name: "valid secondary disks",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("network-ssd"), Size: resource.MustParse("10Gi"), ImageID: "image-id", DeviceName: "data"},
+				{TypeID: ptr.To("network-ssd-nonreplicated"), Size: resource.MustParse("186Gi"), DeviceName: "etcd"},
+				{TypeID: ptr.To("network-ssd-io-m3"), Size: resource.MustParse("93Gi"), DeviceName: "logs"},
+				{TypeID: ptr.To("local"), Size: resource.MustParse("368Gi")},
+			},
+			hostGroupID: "fd8abcdefgh123456789",
+			wantErr:     false,
+		},
+		{
+			name: "local disk without host group",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("local"), Size: resource.MustParse("368Gi")},
+			},
+			wantErr: true,
+		},
+		{
+			name:        "invalid host group",
+			hostGroupID: "host-group",
+			wantErr:     true,
+		},
+		{
+			name: "both image and snapshot are set",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("network-ssd"), Size: resource.MustParse("10Gi"), ImageID: "image-id", SnapshotID: "snapshot-id"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "local disk from snapshot",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("local"), Size: resource.MustParse("368Gi"), SnapshotID: "snapshot-id"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "local disk with device name",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("local"), Size: resource.MustParse("368Gi"), DeviceName: "scratch"},
+			},
+			hostGroupID: "fd8abcdefgh123456789",
+			wantErr:     true,
+		},
+		{
+			name: "non-replicated disk of invalid size",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("network-ssd-nonreplicated"), Size: resource.MustParse("100Gi")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "io-m3 disk of invalid size",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("network-ssd-io-m3"), Size: resource.MustParse("100Gi")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate device names",
+			disks: []infrav1.SecondaryDisk{
+				{TypeID: ptr.To("network-ssd"), Size: resource.MustParse("10Gi"), DeviceName: "data"},
+				{TypeID: ptr.To("network-hdd"), Size: resource.MustParse("10Gi"), DeviceName: "data"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(_ *testing.T) {
+			ym := &infrav1.YandexMachine{
+				ObjectMeta: v1.ObjectMeta{Name: "ym-test"},
+				Spec: infrav1.YandexMachineSpec{
+					BootDisk:          test.bootDisk,
+					SecondaryDisks:    test.disks,
+					HostGroupID:       test.hostGroupID,
+					ServiceAccountID:  test.serviceAccountID,
+					NetworkInterfaces: test.interfaces,
+					Metadata:          test.metadata,
+					SSHKeys:           test.sshKeys,
+					BootstrapFormat:   test.bootstrapFormat,
+				},
+			}
+			warn, err := ym.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(warn).To(BeNil())
+		})
+	}
+}
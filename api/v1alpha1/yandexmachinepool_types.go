@@ -0,0 +1,145 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/errors"
+)
+
+const (
+	// MachinePoolFinalizer allows cleaning up resources associated with
+	// YandexMachinePool before removing it from the apiserver.
+	MachinePoolFinalizer = "yandexmachinepool.infrastructure.cluster.x-k8s.io"
+)
+
+// YandexMachinePoolSpec defines the desired state of YandexMachinePool.
+type YandexMachinePoolSpec struct {
+	// ProviderID is the unique identifier of the instance group as specified by the cloud provider.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// ProviderIDList is the list of the unique identifiers of the instance group instances as specified by the cloud provider.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// ServiceAccountID is the identifier of the service account used by the instance group to manage its instances.
+	// The service account should have the compute.editor role on the folder.
+	// More information https://yandex.cloud/ru/docs/compute/concepts/instance-groups/access .
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountID string `json:"serviceAccountID"`
+
+	// ZoneIDs is a list of YandexCloud availability zones where the instance group instances are allocated.
+	// If ZoneIDs not provided, the zones are taken from the MachinePool failure domains,
+	// or the zone of the instance template is used, if the failure domains are not set too.
+	// +optional
+	ZoneIDs []string `json:"zoneIDs,omitempty"`
+
+	// DeployPolicy configures the rolling update of the instance group instances.
+	// +optional
+	DeployPolicy DeployPolicy `json:"deployPolicy,omitempty"`
+
+	// Template is the specification of the instance group instances.
+	// The ProviderID of the template is ignored.
+	Template YandexMachineSpec `json:"template"`
+}
+
+// DeployPolicy defines the rolling update policy of the instance group.
+// More information https://yandex.cloud/ru/docs/compute/concepts/instance-groups/policies/deploy-policy .
+type DeployPolicy struct {
+	// MaxUnavailable is the maximum number of running instances that can be taken offline at the same time during the update.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=0
+	MaxUnavailable int64 `json:"maxUnavailable,omitempty"`
+
+	// MaxExpansion is the maximum number of instances that can be temporarily allocated above the group's target size during the update.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	MaxExpansion int64 `json:"maxExpansion,omitempty"`
+}
+
+// YandexMachinePoolStatus defines the observed state of YandexMachinePool.
+type YandexMachinePoolStatus struct {
+	// Ready is true when the provider resource is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// Replicas is the most recently observed number of running instances of the instance group.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// InstanceGroupStatus is the status of the Yandex instance group.
+	// +optional
+	InstanceGroupStatus string `json:"instanceGroupStatus,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *errors.MachineStatusError `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the YandexMachinePool.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready",description="Instance group is ready"
+//+kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Running instances count"
+//+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.instanceGroupStatus",description="Instance group status"
+
+// YandexMachinePool is the Schema for the yandexmachinepools API.
+type YandexMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YandexMachinePoolSpec   `json:"spec,omitempty"`
+	Status YandexMachinePoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// YandexMachinePoolList contains a list of YandexMachinePool.
+type YandexMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YandexMachinePool `json:"items"`
+}
+
+// GetConditions returns the list of conditions for an Yandex MachinePool API object.
+func (ymp *YandexMachinePool) GetConditions() clusterv1.Conditions {
+	return ymp.Status.Conditions
+}
+
+// SetConditions will set the given conditions on an Yandex MachinePool API object.
+func (ymp *YandexMachinePool) SetConditions(conditions clusterv1.Conditions) {
+	ymp.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&YandexMachinePool{}, &YandexMachinePoolList{})
+}
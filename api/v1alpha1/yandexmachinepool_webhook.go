@@ -0,0 +1,151 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var ympllog = logf.Log.WithName("yandexmachinepool-resource")
+
+// SetupWebhookWithManager creates an YandexMachinePool validation webhook.
+func (p *YandexMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(p).
+		Complete()
+}
+
+//nolint:lll // controller-gen marker
+//+kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha1-yandexmachinepool,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=yandexmachinepools,verbs=create;update,versions=v1alpha1,name=validation.yandexmachinepools.infrastructure.cluster.x-k8s.io,admissionReviewVersions=v1beta1
+
+var (
+	_ webhook.Defaulter = &YandexMachinePool{}
+	_ webhook.Validator = &YandexMachinePool{}
+)
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (p *YandexMachinePool) Default() {
+	ympllog.Info("default", "name", p.Name)
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (p *YandexMachinePool) ValidateCreate() (admission.Warnings, error) {
+	ympllog.Info("validate create", "name", p.Name)
+	return nil, p.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (p *YandexMachinePool) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	ympllog.Info("validate update", "name", p.Name)
+	return nil, p.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (p *YandexMachinePool) ValidateDelete() (admission.Warnings, error) {
+	ympllog.Info("validate delete", "name", p.Name)
+	return nil, nil
+}
+
+// validate validates the YandexMachinePool specification.
+func (p *YandexMachinePool) validate() error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	policy := p.Spec.DeployPolicy
+	if policy.MaxUnavailable == 0 && policy.MaxExpansion == 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("deployPolicy"), policy,
+			"at least one of maxUnavailable and maxExpansion should be greater than zero"))
+	}
+
+	interfacesPath := specPath.Child("template", "networkInterfaces")
+	if len(p.Spec.Template.NetworkInterfaces) == 0 {
+		allErrs = append(allErrs, field.Required(interfacesPath, "at least one network interface is required"))
+	}
+
+	// The instance group template is shared by all the instances, so the addresses can not be static.
+	for i, networkInterface := range p.Spec.Template.NetworkInterfaces {
+		if networkInterface.Address != "" {
+			allErrs = append(allErrs, field.Forbidden(interfacesPath.Index(i).Child("address"),
+				"static addresses are not supported by instance groups"))
+		}
+		if networkInterface.AddressFromPool != nil {
+			allErrs = append(allErrs, field.Forbidden(interfacesPath.Index(i).Child("addressFromPool"),
+				"IPAM pools are not supported by instance groups"))
+		}
+		if networkInterface.PublicAddressID != "" {
+			allErrs = append(allErrs, field.Forbidden(interfacesPath.Index(i).Child("publicAddressID"),
+				"reserved public addresses are not supported by instance groups"))
+		}
+	}
+	allErrs = append(allErrs, validateNetworkInterfaces(interfacesPath, p.Spec.Template.NetworkInterfaces)...)
+
+	// A subnet belongs to a single zone, so the instances spread over several zones
+	// should take the subnets from the failure domains.
+	if len(p.Spec.ZoneIDs) > 1 {
+		for i, networkInterface := range p.Spec.Template.NetworkInterfaces {
+			if networkInterface.SubnetID != "" {
+				allErrs = append(allErrs, field.Invalid(interfacesPath.Index(i).Child("subnetID"), networkInterface.SubnetID,
+					"subnet cannot be set for the instance group allocated in several zones"))
+			}
+		}
+	}
+
+	bootDiskPath := specPath.Child("template", "bootDisk")
+	allErrs = append(allErrs, validateBootDisk(bootDiskPath, p.Spec.Template.BootDisk)...)
+	// The instance group template is applied to the new instances on scaling,
+	// so the image family can not be resolved once as for a single machine.
+	if p.Spec.Template.BootDisk != nil && p.Spec.Template.BootDisk.ImageFamily != nil {
+		allErrs = append(allErrs, field.Forbidden(bootDiskPath.Child("imageFamily"),
+			"image family is not supported by machine pools, use imageID"))
+	}
+
+	allErrs = append(allErrs, validateServiceAccountID(
+		specPath.Child("template", "serviceAccountID"), p.Spec.Template.ServiceAccountID)...)
+	allErrs = append(allErrs, validateMetadata(specPath.Child("template", "metadata"), p.Spec.Template.Metadata)...)
+	allErrs = append(allErrs, validateSSHKeys(specPath.Child("template", "sshKeys"), p.Spec.Template.SSHKeys)...)
+
+	disksPath := specPath.Child("template", "secondaryDisks")
+	allErrs = append(allErrs, validateSecondaryDisks(disksPath, p.Spec.Template.SecondaryDisks)...)
+	for i, disk := range p.Spec.Template.SecondaryDisks {
+		if disk.TypeID != nil && *disk.TypeID == DiskTypeLocal {
+			allErrs = append(allErrs, field.Forbidden(disksPath.Index(i).Child("typeID"),
+				"local disks are not supported by instance groups"))
+		}
+		// The instance group always deletes the disks together with the instances.
+		if disk.AutoDelete != nil && !*disk.AutoDelete {
+			allErrs = append(allErrs, field.Forbidden(disksPath.Index(i).Child("autoDelete"),
+				"disks of instance groups are always deleted with the instances"))
+		}
+	}
+	if p.Spec.Template.HostGroupID != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("template", "hostGroupID"),
+			"dedicated host groups are not supported by instance groups"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("YandexMachinePool").GroupKind(), p.Name, allErrs)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestYandexMachinePool_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name    string
+		pool    *infrav1.YandexMachinePool
+		wantErr bool
+	}{
+		{
+			name: "valid machine pool",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					ZoneIDs:      []string{"ru-central1-a", "ru-central1-b"},
+					DeployPolicy: infrav1.DeployPolicy{MaxExpansion: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "deploy policy does not allow rolling update",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no network interfaces",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "local secondary disk",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{}},
+						SecondaryDisks: []infrav1.SecondaryDisk{
+							{TypeID: ptr.To(infrav1.DiskTypeLocal), Size: resource.MustParse("368Gi")},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary disk kept after instance deletion",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{}},
+						SecondaryDisks: []infrav1.SecondaryDisk{
+							{TypeID: ptr.To("network-hdd"), Size: resource.MustParse("100Gi"), AutoDelete: ptr.To(false)},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dedicated host group",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{}},
+						HostGroupID:       "fd8abcdefgh123456789",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "static network interface address",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{Address: "10.0.0.10"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "load balancer target network interface",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{LoadBalancerTarget: true}, {}},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "several load balancer target network interfaces",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					DeployPolicy: infrav1.DeployPolicy{MaxUnavailable: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{
+							{LoadBalancerTarget: true},
+							{LoadBalancerTarget: true},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "subnet is set for several zones",
+			pool: &infrav1.YandexMachinePool{
+				ObjectMeta: v1.ObjectMeta{Name: "ymp-test"},
+				Spec: infrav1.YandexMachinePoolSpec{
+					ZoneIDs:      []string{"ru-central1-a", "ru-central1-b"},
+					DeployPolicy: infrav1.DeployPolicy{MaxExpansion: 1},
+					Template: infrav1.YandexMachineSpec{
+						NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-a"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.pool.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
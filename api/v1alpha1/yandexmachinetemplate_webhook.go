@@ -69,6 +69,23 @@ func (t *YandexMachineTemplate) ValidateCreate() (admission.Warnings, error) {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "providerID"), "cannot be set in templates"))
 	}
 
+	allErrs = append(allErrs, validateBootDisk(
+		field.NewPath("spec", "template", "spec", "bootDisk"), t.Spec.Template.Spec.BootDisk)...)
+	allErrs = append(allErrs, validateSecondaryDisks(
+		field.NewPath("spec", "template", "spec", "secondaryDisks"), t.Spec.Template.Spec.SecondaryDisks)...)
+	allErrs = append(allErrs, validateHostGroupID(field.NewPath("spec", "template", "spec", "hostGroupID"),
+		t.Spec.Template.Spec.HostGroupID, t.Spec.Template.Spec.SecondaryDisks)...)
+	allErrs = append(allErrs, validateServiceAccountID(
+		field.NewPath("spec", "template", "spec", "serviceAccountID"), t.Spec.Template.Spec.ServiceAccountID)...)
+	allErrs = append(allErrs, validateNetworkInterfaces(
+		field.NewPath("spec", "template", "spec", "networkInterfaces"), t.Spec.Template.Spec.NetworkInterfaces)...)
+	allErrs = append(allErrs, validateMetadata(
+		field.NewPath("spec", "template", "spec", "metadata"), t.Spec.Template.Spec.Metadata)...)
+	allErrs = append(allErrs, validateSSHKeys(
+		field.NewPath("spec", "template", "spec", "sshKeys"), t.Spec.Template.Spec.SSHKeys)...)
+	allErrs = append(allErrs, validateBootstrapFormat(field.NewPath("spec", "template", "spec", "bootstrapFormat"),
+		t.Spec.Template.Spec.BootstrapFormat, t.Spec.Template.Spec.BootDisk)...)
+
 	if !nameRegex.MatchString(t.Name) {
 		allErrs = append(allErrs, field.Invalid(
 			field.NewPath("metadata", "name"),
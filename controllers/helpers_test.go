@@ -17,11 +17,14 @@ package controllers //nolint:testpackage // private variables access
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client/mock_client"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
@@ -33,9 +36,12 @@ import (
 
 	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
 	alb "github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	nlb "github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -191,6 +197,22 @@ func (c *ClusterTestEnv) setNewALBReconcileMocks(address string) {
 					[]interface{}{mockID, nil})
 				return mockID, nil
 			}),
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.SecurityGroup, error) {
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.CreateSecurityGroupRequest) (string, error) {
+				logFunctionCalls(
+					"VPCSecurityGroupCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{mockID, nil})
+				return mockID, nil
+			}),
 		e.mockClient.EXPECT().ALBGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, id, name string) (*alb.LoadBalancer, error) {
 				logFunctionCalls(
@@ -291,6 +313,18 @@ func (c *ClusterTestEnv) setExistingALBReconcileMocks(address string) {
 					[]interface{}{backendGroup, nil})
 				return backendGroup, nil
 			}),
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.SecurityGroup, error) {
+				securityGroup := &vpc.SecurityGroup{
+					Id:   mockID,
+					Name: mockName,
+				}
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{securityGroup, nil})
+				return securityGroup, nil
+			}),
 		e.mockClient.EXPECT().ALBGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, id, name string) (*alb.LoadBalancer, error) {
 				loadBalancer := &alb.LoadBalancer{
@@ -326,6 +360,84 @@ func (c *ClusterTestEnv) setExistingALBReconcileMocks(address string) {
 	)
 }
 
+// setExistingExternalALBReconcileMocks mocks YandexCloud client API calls on existing load balancer with the public listener reconciliation.
+func (c *ClusterTestEnv) setExistingExternalALBReconcileMocks(address string) {
+	const (
+		mockID   string = "123"
+		mockName string = "alb"
+	)
+	gomock.InOrder(
+		e.mockClient.EXPECT().
+			ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*alb.TargetGroup, error) {
+				targetGroup := &alb.TargetGroup{
+					Id:   mockID,
+					Name: mockName,
+				}
+				logFunctionCalls(
+					"ALBTargetGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{targetGroup, nil})
+				return targetGroup, nil
+			}),
+		e.mockClient.EXPECT().ALBBackendGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*alb.BackendGroup, error) {
+				backendGroup := &alb.BackendGroup{
+					Id:   mockID,
+					Name: mockName,
+				}
+				logFunctionCalls(
+					"ALBBackendGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{backendGroup, nil})
+				return backendGroup, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.SecurityGroup, error) {
+				securityGroup := &vpc.SecurityGroup{
+					Id:   mockID,
+					Name: mockName,
+				}
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{securityGroup, nil})
+				return securityGroup, nil
+			}),
+		e.mockClient.EXPECT().ALBGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*alb.LoadBalancer, error) {
+				loadBalancer := &alb.LoadBalancer{
+					Id:     mockID,
+					Name:   mockName,
+					Status: alb.LoadBalancer_ACTIVE,
+					Listeners: []*alb.Listener{
+						{
+							Endpoints: []*alb.Endpoint{
+								{
+									Ports: []int64{8443},
+									Addresses: []*alb.Address{
+										{
+											Address: &alb.Address_ExternalIpv4Address{
+												ExternalIpv4Address: &alb.ExternalIpv4Address{
+													Address: address,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				logFunctionCalls(
+					"ALBGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{loadBalancer, nil})
+				return loadBalancer, nil
+			}).Times(3),
+	)
+}
+
 // setExistingALBDeleteMocks mocks YandexCloud client API calls on existing application load balancer deletion.
 func (c *ClusterTestEnv) setExistingALBDeleteMocks(mockName, mockID string) {
 	gomock.InOrder(
@@ -380,6 +492,14 @@ func (c *ClusterTestEnv) setNonExistingALBDeleteMocks(name string) {
 					[]interface{}{nil, nil})
 				return nil, nil
 			}),
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.SecurityGroup, error) {
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
 	)
 }
 
@@ -459,10 +579,359 @@ func (c *ClusterTestEnv) setExistingTargetGroupDeleteMocks(lbName, tgName, mockI
 	)
 }
 
+// setNewNLBReconcileMocks mocks YandexCloud client API calls on new network load balancer reconciliation.
+func (c *ClusterTestEnv) setNewNLBReconcileMocks(address string) {
+	const (
+		mockID   string = "123"
+		mockName string = "nlb"
+	)
+	gomock.InOrder(
+		e.mockClient.EXPECT().NLBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*nlb.TargetGroup, error) {
+				logFunctionCalls(
+					"NLBTargetGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().NLBTargetGroupCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *nlb.CreateTargetGroupRequest) (string, error) {
+				logFunctionCalls(
+					"NLBTargetGroupCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{mockID, nil})
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().NLBGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*nlb.NetworkLoadBalancer, error) {
+				logFunctionCalls(
+					"NLBGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().NLBCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *nlb.CreateNetworkLoadBalancerRequest) (string, error) {
+				logFunctionCalls(
+					"NLBCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{mockID, nil})
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().NLBGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*nlb.NetworkLoadBalancer, error) {
+				loadBalancer := &nlb.NetworkLoadBalancer{
+					Id:     mockID,
+					Name:   mockName,
+					Status: nlb.NetworkLoadBalancer_INACTIVE,
+					Listeners: []*nlb.Listener{
+						{
+							Address: address,
+							Port:    8443,
+						},
+					},
+				}
+				logFunctionCalls(
+					"NLBGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{loadBalancer, nil})
+				return loadBalancer, nil
+			}).Times(2),
+	)
+}
+
+// setExistingNLBDeleteMocks mocks YandexCloud client API calls on existing network load balancer deletion.
+func (c *ClusterTestEnv) setExistingNLBDeleteMocks(mockName, mockID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().NLBGetByName(gomock.Any(), gomock.Any(), mockName).
+			DoAndReturn(func(_ context.Context, id, name string) (*nlb.NetworkLoadBalancer, error) {
+				loadBalancer := &nlb.NetworkLoadBalancer{
+					Id:     mockID,
+					Name:   mockName,
+					Status: nlb.NetworkLoadBalancer_ACTIVE,
+				}
+				logFunctionCalls(
+					"NLBGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{loadBalancer, nil})
+				return loadBalancer, nil
+			}),
+		e.mockClient.EXPECT().NLBDelete(gomock.Any(), mockID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"NLBDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
+// setNonExistingNLBDeleteMocks mocks YandexCloud client API calls on non existing network load balancer deletion.
+func (c *ClusterTestEnv) setNonExistingNLBDeleteMocks(name string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().NLBGetByName(gomock.Any(), gomock.Any(), name).
+			DoAndReturn(func(_ context.Context, id, name string) (*nlb.NetworkLoadBalancer, error) {
+				logFunctionCalls(
+					"NLBGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().NLBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*nlb.TargetGroup, error) {
+				logFunctionCalls(
+					"NLBTargetGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+	)
+}
+
+// setNewNetworkReconcileMocks mocks YandexCloud client API calls on managed network and subnet creation.
+func (c *ClusterTestEnv) setNewNetworkReconcileMocks(networkName, subnetName, networkID, subnetID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCNetworkGetByName(gomock.Any(), gomock.Any(), networkName).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.Network, error) {
+				logFunctionCalls(
+					"VPCNetworkGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCNetworkCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.CreateNetworkRequest) (string, error) {
+				logFunctionCalls(
+					"VPCNetworkCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{networkID, nil})
+				return networkID, nil
+			}),
+		e.mockClient.EXPECT().VPCSubnetGetByName(gomock.Any(), gomock.Any(), subnetName).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.Subnet, error) {
+				logFunctionCalls(
+					"VPCSubnetGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCSubnetCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.CreateSubnetRequest) (string, error) {
+				logFunctionCalls(
+					"VPCSubnetCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{subnetID, nil})
+				return subnetID, nil
+			}),
+	)
+}
+
+// setNewNATGatewayReconcileMocks mocks YandexCloud client API calls on managed NAT gateway and route table creation.
+func (c *ClusterTestEnv) setNewNATGatewayReconcileMocks(gatewayID, routeTableID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCGatewayGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.Gateway, error) {
+				logFunctionCalls(
+					"VPCGatewayGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCGatewayCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.CreateGatewayRequest) (string, error) {
+				logFunctionCalls(
+					"VPCGatewayCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{gatewayID, nil})
+				return gatewayID, nil
+			}),
+		e.mockClient.EXPECT().VPCRouteTableGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.RouteTable, error) {
+				logFunctionCalls(
+					"VPCRouteTableGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCRouteTableCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.CreateRouteTableRequest) (string, error) {
+				logFunctionCalls(
+					"VPCRouteTableCreate",
+					map[string]interface{}{"req": req},
+					[]interface{}{routeTableID, nil})
+				return routeTableID, nil
+			}),
+	)
+}
+
+// setExistingNATGatewayDeleteMocks mocks YandexCloud client API calls on existing managed NAT gateway and route table deletion.
+func (c *ClusterTestEnv) setExistingNATGatewayDeleteMocks(gatewayID, routeTableID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCRouteTableGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.RouteTable, error) {
+				routeTable := &vpc.RouteTable{
+					Id:   routeTableID,
+					Name: name,
+				}
+				logFunctionCalls(
+					"VPCRouteTableGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{routeTable, nil})
+				return routeTable, nil
+			}),
+		e.mockClient.EXPECT().VPCRouteTableDelete(gomock.Any(), routeTableID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"VPCRouteTableDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+		e.mockClient.EXPECT().VPCGatewayGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.Gateway, error) {
+				gateway := &vpc.Gateway{
+					Id:   gatewayID,
+					Name: name,
+				}
+				logFunctionCalls(
+					"VPCGatewayGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{gateway, nil})
+				return gateway, nil
+			}),
+		e.mockClient.EXPECT().VPCGatewayDelete(gomock.Any(), gatewayID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"VPCGatewayDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
+// setExistingNetworkDeleteMocks mocks YandexCloud client API calls on existing managed network and subnet deletion.
+func (c *ClusterTestEnv) setExistingNetworkDeleteMocks(networkName, subnetName, networkID, subnetID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCSubnetGetByName(gomock.Any(), gomock.Any(), subnetName).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.Subnet, error) {
+				subnet := &vpc.Subnet{
+					Id:   subnetID,
+					Name: subnetName,
+				}
+				logFunctionCalls(
+					"VPCSubnetGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{subnet, nil})
+				return subnet, nil
+			}),
+		e.mockClient.EXPECT().VPCSubnetDelete(gomock.Any(), subnetID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"VPCSubnetDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+		e.mockClient.EXPECT().VPCNetworkGetByName(gomock.Any(), gomock.Any(), networkName).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.Network, error) {
+				network := &vpc.Network{
+					Id:   networkID,
+					Name: networkName,
+				}
+				logFunctionCalls(
+					"VPCNetworkGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{network, nil})
+				return network, nil
+			}),
+		e.mockClient.EXPECT().VPCNetworkDelete(gomock.Any(), networkID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"VPCNetworkDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
+// setExistingSecurityGroupMocks mocks YandexCloud client API calls on existing load balancer security group reconciliation.
+func (c *ClusterTestEnv) setExistingSecurityGroupMocks() {
+	const (
+		mockID   string = "123"
+		mockName string = "alb"
+	)
+	e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id, name string) (*vpc.SecurityGroup, error) {
+			securityGroup := &vpc.SecurityGroup{
+				Id:   mockID,
+				Name: mockName,
+			}
+			logFunctionCalls(
+				"VPCSecurityGroupGetByName",
+				map[string]interface{}{"id": id, "name": name},
+				[]interface{}{securityGroup, nil})
+			return securityGroup, nil
+		}).AnyTimes()
+}
+
+// setExistingSecurityGroupDeleteMocks mocks YandexCloud client API calls on existing load balancer security group deletion.
+func (c *ClusterTestEnv) setExistingSecurityGroupDeleteMocks(lbName, sgName, mockID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().ALBGetByName(gomock.Any(), gomock.Any(), lbName).
+			DoAndReturn(func(_ context.Context, id, name string) (*alb.LoadBalancer, error) {
+				logFunctionCalls(
+					"ALBGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().ALBBackendGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*alb.BackendGroup, error) {
+				logFunctionCalls(
+					"ALBBackendGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id, name string) (*alb.TargetGroup, error) {
+				logFunctionCalls(
+					"ALBTargetGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), sgName).
+			DoAndReturn(func(_ context.Context, id, name string) (*vpc.SecurityGroup, error) {
+				securityGroup := &vpc.SecurityGroup{
+					Id:   mockID,
+					Name: sgName,
+				}
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"id": id, "name": name},
+					[]interface{}{securityGroup, nil})
+				return securityGroup, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupDelete(gomock.Any(), mockID).DoAndReturn(
+			func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"VPCSecurityGroupDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
 // setNewTargetGroupErrorMocks mocks YandexCloud client API calls on ALB target group creation with API errors.
 func (c *ClusterTestEnv) setNewTargetGroupErrorMocks() {
 	const mockID string = "123"
 
+	c.setExistingSecurityGroupMocks()
 	gomock.InOrder(
 		e.mockClient.EXPECT().ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, id, name string) (*alb.TargetGroup, error) {
@@ -524,6 +993,7 @@ func (c *ClusterTestEnv) setNewBackendGroupErrorMocks() {
 		mockID   string = "123"
 		mockName string = "alb"
 	)
+	c.setExistingSecurityGroupMocks()
 	gomock.InOrder(
 		e.mockClient.EXPECT().
 			ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -625,6 +1095,7 @@ func (c *ClusterTestEnv) setNewALBErrorMocks(address string) {
 		mockName string = "alb"
 	)
 	albAddress := address
+	c.setExistingSecurityGroupMocks()
 	gomock.InOrder(
 		e.mockClient.EXPECT().
 			ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -944,6 +1415,173 @@ func (c *ClusterTestEnv) setNewYandexMachineReconcileMocks(address string) {
 	)
 }
 
+// setYandexMachineAddressFromPoolReconcileMocks mocks the YandexClient API calls on YandexMachine reconciliation,
+// which creates the instance with the network interface address claimed from IPAM pool.
+func (c *ClusterTestEnv) setYandexMachineAddressFromPoolReconcileMocks(address string) {
+	const mockID string = "123"
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().ComputeCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.CreateInstanceRequest) (string, error) {
+				logFunctionCalls(
+					"ComputeCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{mockID, nil})
+				if got := req.GetNetworkInterfaceSpecs()[0].GetPrimaryV4AddressSpec().GetAddress(); got != address {
+					return "", fmt.Errorf("unexpected network interface address %q", got)
+				}
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), mockID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:   c.machineName,
+					Id:     mockID,
+					Status: compute.Instance_STARTING,
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+	)
+}
+
+// setRunningYandexMachinePurgeBootstrapDataMocks mocks the YandexClient API calls on the running YandexMachine
+// reconciliation, which removes the bootstrap data from the instance metadata.
+func (c *ClusterTestEnv) setRunningYandexMachinePurgeBootstrapDataMocks(instanceID, address string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), instanceID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:   c.machineName,
+					Id:     instanceID,
+					Status: compute.Instance_RUNNING,
+					NetworkInterfaces: []*compute.NetworkInterface{
+						{
+							PrimaryV4Address: &compute.PrimaryAddress{
+								Address: address,
+							},
+						},
+					},
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+		e.mockClient.EXPECT().ComputeUpdateMetadata(gomock.Any(), instanceID, gomock.Nil(), []string{infrav1.MetadataKeyUserData}).
+			DoAndReturn(func(_ context.Context, id string, upsert map[string]string, remove []string) error {
+				logFunctionCalls(
+					"ComputeUpdateMetadata",
+					map[string]interface{}{"id": id, "upsert": upsert, "remove": remove},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
+// setRunningYandexMachineRestoreDriftMocks mocks the YandexClient API calls on the running YandexMachine
+// reconciliation, which restores the drifted instance labels and metadata.
+func (c *ClusterTestEnv) setRunningYandexMachineRestoreDriftMocks(instanceID, address string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), instanceID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:   c.machineName,
+					Id:     instanceID,
+					Status: compute.Instance_RUNNING,
+					Labels: map[string]string{"owner": "someone"},
+					NetworkInterfaces: []*compute.NetworkInterface{
+						{
+							PrimaryV4Address: &compute.PrimaryAddress{
+								Address: address,
+							},
+						},
+					},
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+		e.mockClient.EXPECT().ComputeUpdate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.UpdateInstanceRequest) error {
+				logFunctionCalls(
+					"ComputeUpdate",
+					map[string]interface{}{"req": req},
+					[]interface{}{nil})
+				return nil
+			}),
+		e.mockClient.EXPECT().
+			ComputeUpdateMetadata(gomock.Any(), instanceID, map[string]string{"serial-port-enable": "1"}, gomock.Nil()).
+			DoAndReturn(func(_ context.Context, id string, upsert map[string]string, remove []string) error {
+				logFunctionCalls(
+					"ComputeUpdateMetadata",
+					map[string]interface{}{"id": id, "upsert": upsert, "remove": remove},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
+// setYandexMachineInPlaceResizeMocks mocks the YandexClient API calls on the YandexMachine reconciliations,
+// which stop the instance, update its resources from 1 to 2 cores and start it again.
+func (c *ClusterTestEnv) setYandexMachineInPlaceResizeMocks(instanceID, address string) {
+	getInstance := func(status compute.Instance_Status, cores int64) *gomock.Call {
+		return e.mockClient.EXPECT().ComputeGet(gomock.Any(), instanceID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:       c.machineName,
+					Id:         instanceID,
+					Status:     status,
+					PlatformId: "standard-v3",
+					Resources:  &compute.Resources{Cores: cores, Memory: 1 << 30, CoreFraction: 100},
+					NetworkInterfaces: []*compute.NetworkInterface{
+						{
+							PrimaryV4Address: &compute.PrimaryAddress{
+								Address: address,
+							},
+						},
+					},
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			})
+	}
+
+	gomock.InOrder(
+		getInstance(compute.Instance_RUNNING, 1),
+		e.mockClient.EXPECT().ComputeStop(gomock.Any(), instanceID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls("ComputeStop", map[string]interface{}{"id": id}, []interface{}{nil})
+				return nil
+			}),
+		getInstance(compute.Instance_STOPPED, 1),
+		e.mockClient.EXPECT().ComputeUpdate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.UpdateInstanceRequest) error {
+				logFunctionCalls("ComputeUpdate", map[string]interface{}{"req": req}, []interface{}{nil})
+				if req.GetResourcesSpec().GetCores() != 2 {
+					return fmt.Errorf("unexpected cores %d", req.GetResourcesSpec().GetCores())
+				}
+				return nil
+			}),
+		getInstance(compute.Instance_STOPPED, 2),
+		e.mockClient.EXPECT().ComputeStart(gomock.Any(), instanceID).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls("ComputeStart", map[string]interface{}{"id": id}, []interface{}{nil})
+				return nil
+			}),
+		getInstance(compute.Instance_RUNNING, 2),
+	)
+}
+
 // setNewYandexMachineErrorReconcileMocks mocks the YandexClient API calls on YandexMachine reconciliation with API errors.
 func (c *ClusterTestEnv) setNewYandexMachineErrorReconcileMocks() {
 	const mockID string = "123"
@@ -1534,3 +2172,508 @@ func (c *ClusterTestEnv) setCPYandexMachineWithoutTargetGroupDeleteMocks(mockID,
 			}),
 	)
 }
+
+// getYandexMachinePool returns YandexMachinePool specification.
+func (c *ClusterTestEnv) getYandexMachinePool(nsName string) *infrav1.YandexMachinePool {
+	return &infrav1.YandexMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.machineName,
+			Namespace: nsName,
+		},
+		Spec: infrav1.YandexMachinePoolSpec{
+			ServiceAccountID: "serviceaccountid",
+			DeployPolicy:     infrav1.DeployPolicy{MaxExpansion: 1},
+			Template:         c.getYandexMachine(nsName).Spec,
+		},
+	}
+}
+
+// getYandexMachinePoolWithOwnerRef returns YandexMachinePool specification with CAPI MachinePool owner reference.
+func (c *ClusterTestEnv) getYandexMachinePoolWithOwnerRef(nsName string) *infrav1.YandexMachinePool {
+	ymp := c.getYandexMachinePool(nsName)
+	ymp.ObjectMeta.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "cluster.x-k8s.io/v1beta1",
+		Kind:       "MachinePool",
+		Name:       c.machineName,
+		UID:        types.UID("uid"),
+	}}
+
+	return ymp
+}
+
+// getMachinePoolWithInfrastructureRef returns CAPI MachinePool with infrastructure reference.
+func (c *ClusterTestEnv) getMachinePoolWithInfrastructureRef(nsName string) *expclusterv1.MachinePool {
+	return &expclusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: c.clusterName,
+			},
+			Name:      c.machineName,
+			Namespace: nsName,
+		},
+		Spec: expclusterv1.MachinePoolSpec{
+			ClusterName: c.clusterName,
+			Replicas:    ptr.To[int32](1),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					ClusterName: c.clusterName,
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To(c.secretName),
+					},
+					InfrastructureRef: corev1.ObjectReference{
+						Kind:       "YandexMachinePool",
+						Namespace:  nsName,
+						Name:       c.machineName,
+						APIVersion: infrav1.GroupVersion.String(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// setNewYandexMachinePoolReconcileMocks mocks the YandexClient API calls on YandexMachinePool reconciliation.
+func (c *ClusterTestEnv) setNewYandexMachinePoolReconcileMocks(instanceGroupID, instanceID string) {
+	var labels map[string]string
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().InstanceGroupGetByName(gomock.Any(), gomock.Any(), c.machineName).
+			Return(nil, nil),
+		e.mockClient.EXPECT().InstanceGroupCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *instancegroup.CreateInstanceGroupRequest) (string, error) {
+				logFunctionCalls(
+					"InstanceGroupCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{instanceGroupID, nil})
+				labels = req.Labels
+				return instanceGroupID, nil
+			}),
+		e.mockClient.EXPECT().InstanceGroupGetByName(gomock.Any(), gomock.Any(), c.machineName).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*instancegroup.InstanceGroup, error) {
+				ig := &instancegroup.InstanceGroup{
+					Id:     instanceGroupID,
+					Name:   name,
+					Labels: labels,
+					Status: instancegroup.InstanceGroup_ACTIVE,
+					ScalePolicy: &instancegroup.ScalePolicy{
+						ScaleType: &instancegroup.ScalePolicy_FixedScale_{
+							FixedScale: &instancegroup.ScalePolicy_FixedScale{Size: 1},
+						},
+					},
+				}
+				logFunctionCalls(
+					"InstanceGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{ig, nil})
+				return ig, nil
+			}),
+		e.mockClient.EXPECT().InstanceGroupListInstances(gomock.Any(), instanceGroupID).
+			Return([]*instancegroup.ManagedInstance{{
+				Id:         "managed-" + instanceID,
+				InstanceId: instanceID,
+				Status:     instancegroup.ManagedInstance_RUNNING_ACTUAL,
+			}}, nil),
+	)
+}
+
+// setYandexMachinePoolBootstrapRefreshMocks mocks the YandexClient API calls on YandexMachinePool reconciliation
+// with the bootstrap data refreshed after the instance group creation.
+func (c *ClusterTestEnv) setYandexMachinePoolBootstrapRefreshMocks(instanceGroupID, instanceID string) {
+	var labels map[string]string
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().InstanceGroupGetByName(gomock.Any(), gomock.Any(), c.machineName).
+			Return(nil, nil),
+		e.mockClient.EXPECT().InstanceGroupCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *instancegroup.CreateInstanceGroupRequest) (string, error) {
+				logFunctionCalls(
+					"InstanceGroupCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{instanceGroupID, nil})
+				labels = req.Labels
+				return instanceGroupID, nil
+			}),
+		e.mockClient.EXPECT().InstanceGroupGetByName(gomock.Any(), gomock.Any(), c.machineName).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*instancegroup.InstanceGroup, error) {
+				ig := &instancegroup.InstanceGroup{
+					Id:     instanceGroupID,
+					Name:   name,
+					Labels: labels,
+					Status: instancegroup.InstanceGroup_ACTIVE,
+					ScalePolicy: &instancegroup.ScalePolicy{
+						ScaleType: &instancegroup.ScalePolicy_FixedScale_{
+							FixedScale: &instancegroup.ScalePolicy_FixedScale{Size: 1},
+						},
+					},
+				}
+				logFunctionCalls(
+					"InstanceGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{ig, nil})
+				return ig, nil
+			}),
+		e.mockClient.EXPECT().InstanceGroupUpdate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *instancegroup.UpdateInstanceGroupRequest) error {
+				logFunctionCalls(
+					"InstanceGroupUpdate",
+					map[string]interface{}{"request": req},
+					[]interface{}{nil})
+				if req.GetDeployPolicy().GetStrategy() != instancegroup.DeployPolicy_OPPORTUNISTIC {
+					return fmt.Errorf("bootstrap data update has to use opportunistic strategy")
+				}
+				if slices.Contains(req.GetUpdateMask().GetPaths(), "scale_policy") {
+					return fmt.Errorf("bootstrap data update should not change the scale policy")
+				}
+				if req.GetLabels()["yandex.cloud/capy-bootstrap-hash"] == labels["yandex.cloud/capy-bootstrap-hash"] {
+					return fmt.Errorf("bootstrap data hash has not changed")
+				}
+				if req.GetLabels()["yandex.cloud/capy-template-hash"] != labels["yandex.cloud/capy-template-hash"] {
+					return fmt.Errorf("template hash should not change with bootstrap data")
+				}
+				return nil
+			}),
+		e.mockClient.EXPECT().InstanceGroupListInstances(gomock.Any(), instanceGroupID).
+			Return([]*instancegroup.ManagedInstance{{
+				Id:         "managed-" + instanceID,
+				InstanceId: instanceID,
+				Status:     instancegroup.ManagedInstance_RUNNING_OUTDATED,
+			}}, nil),
+	)
+}
+
+// setYandexMachinePoolDeleteMocks mocks the YandexClient API calls on YandexMachinePool deletion.
+func (c *ClusterTestEnv) setYandexMachinePoolDeleteMocks(instanceGroupID string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().InstanceGroupGetByName(gomock.Any(), gomock.Any(), c.machineName).
+			Return(&instancegroup.InstanceGroup{
+				Id:     instanceGroupID,
+				Name:   c.machineName,
+				Status: instancegroup.InstanceGroup_ACTIVE,
+			}, nil),
+		e.mockClient.EXPECT().InstanceGroupDelete(gomock.Any(), instanceGroupID).Return(nil),
+		e.mockClient.EXPECT().InstanceGroupGetByName(gomock.Any(), gomock.Any(), c.machineName).
+			Return(nil, nil),
+	)
+}
+
+// setYandexMachinePreemptedReconcileMocks mocks the YandexClient API calls on YandexMachine reconciliation
+// with the preemptible instance stopped by Compute.
+func (c *ClusterTestEnv) setYandexMachinePreemptedReconcileMocks() {
+	const mockID string = "123"
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().ComputeCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.CreateInstanceRequest) (string, error) {
+				logFunctionCalls(
+					"ComputeCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{mockID, nil})
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), mockID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Id:               mockID,
+					Status:           compute.Instance_STOPPED,
+					SchedulingPolicy: &compute.SchedulingPolicy{Preemptible: true},
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+	)
+}
+
+// setYandexMachineImageFamilyReconcileMocks mocks the YandexClient API calls on YandexMachine reconciliation
+// with the boot disk image family resolved to the image.
+func (c *ClusterTestEnv) setYandexMachineImageFamilyReconcileMocks(folderID, family, imageID string) {
+	const mockID string = "123"
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().ImageGetLatestByFamily(gomock.Any(), folderID, family).
+			DoAndReturn(func(_ context.Context, folderID, family string) (*compute.Image, error) {
+				image := &compute.Image{Id: imageID, FolderId: folderID, Family: family}
+				logFunctionCalls(
+					"ImageGetLatestByFamily",
+					map[string]interface{}{"folderID": folderID, "family": family},
+					[]interface{}{image, nil})
+				return image, nil
+			}),
+		e.mockClient.EXPECT().ComputeCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.CreateInstanceRequest) (string, error) {
+				logFunctionCalls(
+					"ComputeCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{mockID, nil})
+				if req.GetBootDiskSpec().GetDiskSpec().GetImageId() != imageID {
+					return "", fmt.Errorf("unexpected boot disk image %s", req.GetBootDiskSpec().GetDiskSpec().GetImageId())
+				}
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), mockID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:   c.machineName,
+					Id:     mockID,
+					Status: compute.Instance_STARTING,
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+	)
+}
+
+// setYandexMachinePublicAddressReconcileMocks mocks the YandexClient API calls on YandexMachine reconciliation
+// with the reserved public address of the network interface.
+func (c *ClusterTestEnv) setYandexMachinePublicAddressReconcileMocks(addressID, address string) {
+	const mockID string = "123"
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCAddressGet(gomock.Any(), addressID).
+			DoAndReturn(func(_ context.Context, id string) (*vpc.Address, error) {
+				result := &vpc.Address{
+					Id: id,
+					Address: &vpc.Address_ExternalIpv4Address{
+						ExternalIpv4Address: &vpc.ExternalIpv4Address{Address: address},
+					},
+				}
+				logFunctionCalls(
+					"VPCAddressGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{result, nil})
+				return result, nil
+			}),
+		e.mockClient.EXPECT().ComputeCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.CreateInstanceRequest) (string, error) {
+				logFunctionCalls(
+					"ComputeCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{mockID, nil})
+				natAddress := req.GetNetworkInterfaceSpecs()[0].GetPrimaryV4AddressSpec().GetOneToOneNatSpec().GetAddress()
+				if natAddress != address {
+					return "", fmt.Errorf("unexpected public address %s", natAddress)
+				}
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), mockID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:   c.machineName,
+					Id:     mockID,
+					Status: compute.Instance_STARTING,
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+	)
+}
+
+// fakeStorage is the in-memory object storage used instead of the S3-compatible bucket.
+type fakeStorage struct {
+	objects map[string][]byte
+}
+
+// PutObject stores the object in memory.
+func (s *fakeStorage) PutObject(_ context.Context, key string, data []byte) error {
+	s.objects[key] = data
+	return nil
+}
+
+// DeleteObject removes the object from memory.
+func (s *fakeStorage) DeleteObject(_ context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+// PresignGetObject returns the fake object URL.
+func (s *fakeStorage) PresignGetObject(key string) (string, error) {
+	return "https://storage.example.com/bootstrap/" + key + "?X-Amz-Signature=signature", nil
+}
+
+// setYandexMachineBootstrapStorageReconcileMocks mocks the YandexClient API calls on the instance creation
+// with the bootstrap data delivered through the object storage.
+func (c *ClusterTestEnv) setYandexMachineBootstrapStorageReconcileMocks() {
+	const mockID string = "123"
+
+	gomock.InOrder(
+		e.mockClient.EXPECT().ComputeCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.CreateInstanceRequest) (string, error) {
+				logFunctionCalls(
+					"ComputeCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{mockID, nil})
+				userData := req.GetMetadata()[infrav1.MetadataKeyUserData]
+				if !strings.HasPrefix(userData, "#include\nhttps://storage.example.com/bootstrap/") {
+					return "", fmt.Errorf("unexpected user-data %q", userData)
+				}
+				return mockID, nil
+			}),
+		e.mockClient.EXPECT().ComputeGet(gomock.Any(), mockID).
+			DoAndReturn(func(_ context.Context, id string) (*compute.Instance, error) {
+				instance := &compute.Instance{
+					Name:   c.machineName,
+					Id:     mockID,
+					Status: compute.Instance_STARTING,
+				}
+				logFunctionCalls(
+					"ComputeGet",
+					map[string]interface{}{"id": id},
+					[]interface{}{instance, nil})
+				return instance, nil
+			}),
+	)
+}
+
+// setNewNodeSecurityGroupReconcileMocks mocks the YandexClient API calls on the node security group creation.
+func (c *ClusterTestEnv) setNewNodeSecurityGroupReconcileMocks(name, id string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), name).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*vpc.SecurityGroup, error) {
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.CreateSecurityGroupRequest) (string, error) {
+				logFunctionCalls(
+					"VPCSecurityGroupCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{id, nil})
+				if req.GetNetworkId() == "" || len(req.GetRuleSpecs()) == 0 {
+					return "", fmt.Errorf("security group network or rules are not set")
+				}
+				for _, rule := range req.GetRuleSpecs() {
+					if rule.GetDirection() == vpc.SecurityGroupRule_INGRESS &&
+						slices.Contains(rule.GetCidrBlocks().GetV4CidrBlocks(), "0.0.0.0/0") {
+						return "", fmt.Errorf("security group allows incoming traffic from any address")
+					}
+				}
+				return id, nil
+			}),
+	)
+}
+
+// setExistingNodeSecurityGroupIPv6UpdateMocks mocks the YandexClient API calls on the node security group
+// reconciliation, when the existing security group rules do not allow the IPv6 traffic.
+func (c *ClusterTestEnv) setExistingNodeSecurityGroupIPv6UpdateMocks(name, id string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), name).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*vpc.SecurityGroup, error) {
+				sg := &vpc.SecurityGroup{
+					Id:   id,
+					Name: name,
+					Rules: []*vpc.SecurityGroupRule{{
+						Id:           "rule-id",
+						Description:  "outgoing traffic",
+						Direction:    vpc.SecurityGroupRule_EGRESS,
+						ProtocolName: "ANY",
+						Target: &vpc.SecurityGroupRule_CidrBlocks{
+							CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: []string{"0.0.0.0/0"}},
+						},
+					}},
+				}
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{sg, nil})
+				return sg, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupUpdate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *vpc.UpdateSecurityGroupRequest) error {
+				logFunctionCalls(
+					"VPCSecurityGroupUpdate",
+					map[string]interface{}{"request": req},
+					[]interface{}{nil})
+				if req.GetSecurityGroupId() != id || !slices.Equal(req.GetUpdateMask().GetPaths(), []string{"rule_specs"}) {
+					return fmt.Errorf("unexpected security group update request")
+				}
+				for _, rule := range req.GetRuleSpecs() {
+					if slices.Contains(rule.GetCidrBlocks().GetV6CidrBlocks(), "::/0") {
+						return nil
+					}
+				}
+				return fmt.Errorf("security group rules do not allow IPv6 traffic")
+			}),
+	)
+}
+
+// setExistingNodeSecurityGroupDeleteMocks mocks the YandexClient API calls on the node security group deletion.
+func (c *ClusterTestEnv) setExistingNodeSecurityGroupDeleteMocks(name, id string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().VPCSecurityGroupGetByName(gomock.Any(), gomock.Any(), name).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*vpc.SecurityGroup, error) {
+				sg := &vpc.SecurityGroup{Id: id, Name: name}
+				logFunctionCalls(
+					"VPCSecurityGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{sg, nil})
+				return sg, nil
+			}),
+		e.mockClient.EXPECT().VPCSecurityGroupDelete(gomock.Any(), id).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"VPCSecurityGroupDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
+
+// setNewPlacementGroupReconcileMocks mocks the YandexClient API calls on the control plane placement group creation.
+func (c *ClusterTestEnv) setNewPlacementGroupReconcileMocks(name, id string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().PlacementGroupGetByName(gomock.Any(), gomock.Any(), name).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*compute.PlacementGroup, error) {
+				logFunctionCalls(
+					"PlacementGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{nil, nil})
+				return nil, nil
+			}),
+		e.mockClient.EXPECT().PlacementGroupCreate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *compute.CreatePlacementGroupRequest) (string, error) {
+				logFunctionCalls(
+					"PlacementGroupCreate",
+					map[string]interface{}{"request": req},
+					[]interface{}{id, nil})
+				if req.GetSpreadPlacementStrategy() == nil {
+					return "", fmt.Errorf("placement group strategy is not spread")
+				}
+				return id, nil
+			}),
+	)
+}
+
+// setExistingPlacementGroupDeleteMocks mocks the YandexClient API calls on the control plane placement group deletion.
+func (c *ClusterTestEnv) setExistingPlacementGroupDeleteMocks(name, id string) {
+	gomock.InOrder(
+		e.mockClient.EXPECT().PlacementGroupGetByName(gomock.Any(), gomock.Any(), name).
+			DoAndReturn(func(_ context.Context, folderID, name string) (*compute.PlacementGroup, error) {
+				pg := &compute.PlacementGroup{Id: id, Name: name}
+				logFunctionCalls(
+					"PlacementGroupGetByName",
+					map[string]interface{}{"folderID": folderID, "name": name},
+					[]interface{}{pg, nil})
+				return pg, nil
+			}),
+		e.mockClient.EXPECT().PlacementGroupDelete(gomock.Any(), id).
+			DoAndReturn(func(_ context.Context, id string) error {
+				logFunctionCalls(
+					"PlacementGroupDelete",
+					map[string]interface{}{"id": id},
+					[]interface{}{nil})
+				return nil
+			}),
+	)
+}
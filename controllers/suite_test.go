@@ -33,6 +33,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
@@ -85,6 +87,12 @@ var _ = BeforeSuite(func() {
 	err = clusterv1.AddToScheme(scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = expclusterv1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = ipamv1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	err = corev1.AddToScheme(scheme)
 	Expect(err).NotTo(HaveOccurred())
 
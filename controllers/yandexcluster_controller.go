@@ -38,6 +38,9 @@ import (
 	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/network"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/placementgroup"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/securitygroup"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
 )
 
@@ -46,6 +49,7 @@ type YandexClusterReconciler struct {
 	client.Client
 	Scheme       *runtime.Scheme
 	YandexClient yandex.Client
+	ClientCache  *yandex.ClientCache
 	Config       options.Config
 }
 
@@ -53,6 +57,8 @@ type YandexClusterReconciler struct {
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexclusters/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexclusters/finalizers,verbs=update
 //+kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexclusteridentities,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -90,6 +96,7 @@ func (r *YandexClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		Cluster:       cluster,
 		YandexCluster: yandexCluster,
 		YandexClient:  r.YandexClient,
+		ClientCache:   r.ClientCache,
 	})
 	if err != nil {
 		return ctrl.Result{}, err
@@ -120,6 +127,27 @@ func (r *YandexClusterReconciler) reconcile(ctx context.Context, clusterScope *s
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Reconcile the managed network before any resource which could be allocated in its subnets.
+	if err := network.New(clusterScope).Reconcile(ctx); err != nil {
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.NetworkReadyCondition,
+			"network reconcile error", clusterv1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("error reconciling network: %w", err)
+	}
+	conditions.MarkTrue(clusterScope.YandexCluster, infrav1.NetworkReadyCondition)
+
+	// Reconcile the control plane placement group before the control plane machines are created in it.
+	if err := placementgroup.New(clusterScope).Reconcile(ctx); err != nil {
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.PlacementGroupReadyCondition,
+			"placement group reconcile error", clusterv1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("error reconciling placement group: %w", err)
+	}
+	if clusterScope.IsControlPlanePlacementGroupManaged() {
+		conditions.MarkTrue(clusterScope.YandexCluster, infrav1.PlacementGroupReadyCondition)
+	}
+
+	// Publish failure domains, so the control plane machines could be spread across availability zones.
+	clusterScope.SetFailureDomains()
+
 	// Get loadbalancer service and reconcile load balancer.
 	lb := loadbalancer.New(clusterScope)
 	if err := lb.Reconcile(ctx); err != nil {
@@ -128,6 +156,17 @@ func (r *YandexClusterReconciler) reconcile(ctx context.Context, clusterScope *s
 		return ctrl.Result{}, fmt.Errorf("error reconciling load balancer: %w", err)
 	}
 
+	// Reconcile the node security group after the load balancer security group it allows the kubernetes API traffic from,
+	// but before the machines are attached to it.
+	if err := securitygroup.New(clusterScope).Reconcile(ctx); err != nil {
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.NodeSecurityGroupReadyCondition,
+			"node security group reconcile error", clusterv1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("error reconciling node security group: %w", err)
+	}
+	if clusterScope.IsNodeSecurityGroupManaged() {
+		conditions.MarkTrue(clusterScope.YandexCluster, infrav1.NodeSecurityGroupReadyCondition)
+	}
+
 	active, err := lb.IsActive(ctx)
 	if err != nil {
 		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.LoadBalancerReadyCondition,
@@ -197,16 +236,59 @@ func (r *YandexClusterReconciler) reconcileDelete(ctx context.Context, clusterSc
 		return ctrl.Result{}, fmt.Errorf("error deleting load balancer  %w", err)
 	}
 
-	if deleted {
-		logger.Info("load balancer has been deleted")
-		controllerutil.RemoveFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)
-		return ctrl.Result{}, nil
+	if !deleted {
+		logger.V(1).Info("load balancer is being deleted, requeueing")
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.LoadBalancerReadyCondition,
+			clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+	}
+	logger.Info("load balancer has been deleted")
+
+	deleted, err = placementgroup.New(clusterScope).Delete(ctx)
+	if err != nil {
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.PlacementGroupReadyCondition,
+			clusterv1.DeletionFailedReason, clusterv1.ConditionSeverityWarning, "")
+		return ctrl.Result{}, fmt.Errorf("error deleting placement group: %w", err)
+	}
+
+	if !deleted {
+		logger.V(1).Info("placement group is being deleted, requeueing")
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.PlacementGroupReadyCondition,
+			clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+	}
+
+	deleted, err = securitygroup.New(clusterScope).Delete(ctx)
+	if err != nil {
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.NodeSecurityGroupReadyCondition,
+			clusterv1.DeletionFailedReason, clusterv1.ConditionSeverityWarning, "")
+		return ctrl.Result{}, fmt.Errorf("error deleting node security group: %w", err)
+	}
+
+	if !deleted {
+		logger.V(1).Info("node security group is being deleted, requeueing")
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.NodeSecurityGroupReadyCondition,
+			clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+	}
+
+	// The managed network could be deleted only when all the resources allocated in its subnets are deleted.
+	deleted, err = network.New(clusterScope).Delete(ctx)
+	if err != nil {
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.NetworkReadyCondition,
+			clusterv1.DeletionFailedReason, clusterv1.ConditionSeverityWarning, "")
+		return ctrl.Result{}, fmt.Errorf("error deleting network: %w", err)
+	}
+
+	if !deleted {
+		logger.V(1).Info("network is being deleted, requeueing")
+		conditions.MarkFalse(clusterScope.YandexCluster, infrav1.NetworkReadyCondition,
+			clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: RequeueDuration}, nil
 	}
 
-	logger.V(1).Info("load balancer is being deleted, requeueing")
-	conditions.MarkFalse(clusterScope.YandexCluster, infrav1.LoadBalancerReadyCondition,
-		clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
-	return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+	controllerutil.RemoveFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)
+	return ctrl.Result{}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
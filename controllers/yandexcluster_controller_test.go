@@ -26,6 +26,7 @@ import (
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers/builders"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
 	"go.uber.org/mock/gomock"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -221,6 +222,261 @@ var _ = Describe("YandexCluster reconciliation check", func() {
 			Expect(yc.Status.LoadBalancer.ListenerPort).To(Equal(int32(8443)))
 		})
 
+		It("should create network load balancer if it does not exists and set ready status", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+
+			// reconciler sets finalizer here.
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			ip := "1.2.3.4"
+			e.setNewNLBReconcileMocks(ip)
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Spec.ControlPlaneEndpoint.Host).To(Equal(ip))
+			Expect(yc.Spec.ControlPlaneEndpoint.Port).To(Equal(int32(8443)))
+			Expect(yc.Status.LoadBalancer.ListenerAddress).To(Equal(ip))
+			Expect(yc.Status.LoadBalancer.ListenerPort).To(Equal(int32(8443)))
+		})
+
+		It("should create managed network before network load balancer and set network status", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.NetworkSpec = infrav1.NetworkSpec{
+				Subnets: []infrav1.NetworkSubnetSpec{
+					{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+				},
+			}
+			yc.Spec.LoadBalancer.Listener.Subnet = infrav1.SubnetSpec{ZoneID: "ru-central1-a"}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+
+			// reconciler sets finalizer here.
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			ip := "1.2.3.4"
+			networkName := e.clusterName + "-network"
+			e.setNewNetworkReconcileMocks(networkName, networkName+"-ru-central1-a", "network-id", "subnet-id")
+			e.setNewNLBReconcileMocks(ip)
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Status.Network.ID).To(Equal("network-id"))
+			Expect(yc.Status.Network.Subnets).To(ConsistOf(infrav1.SubnetStatus{
+				ID:        "subnet-id",
+				ZoneID:    "ru-central1-a",
+				CIDRBlock: "10.1.0.0/24",
+			}))
+			Expect(conditions.IsTrue(yc, infrav1.NetworkReadyCondition)).To(BeTrue())
+		})
+
+		It("should create control plane placement group and set its status", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.ControlPlanePlacementGroup = &infrav1.PlacementGroupSpec{}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+
+			// reconciler sets finalizer here.
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			e.setNewPlacementGroupReconcileMocks(e.clusterName+"-control-plane", "placement-group-id")
+			e.setNewNLBReconcileMocks("1.2.3.4")
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Status.ControlPlanePlacementGroupID).To(Equal("placement-group-id"))
+			Expect(conditions.IsTrue(yc, infrav1.PlacementGroupReadyCondition)).To(BeTrue())
+		})
+
+		It("should create node security group and set its status", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.NodeSecurityGroup = &infrav1.SecurityGroupSpec{}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+
+			// reconciler sets finalizer here.
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			e.setNewNodeSecurityGroupReconcileMocks(e.clusterName+"-nodes", "security-group-id")
+			e.setNewNLBReconcileMocks("1.2.3.4")
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Status.NodeSecurityGroupID).To(Equal("security-group-id"))
+			Expect(conditions.IsTrue(yc, infrav1.NodeSecurityGroupReadyCondition)).To(BeTrue())
+		})
+
+		It("should update existing node security group rules to allow IPv6 traffic", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.NodeSecurityGroup = &infrav1.SecurityGroupSpec{IPv6: true}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+
+			// reconciler sets finalizer here.
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			e.setExistingNodeSecurityGroupIPv6UpdateMocks(e.clusterName+"-nodes", "security-group-id")
+			e.setNewNLBReconcileMocks("1.2.3.4")
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Status.NodeSecurityGroupID).To(Equal("security-group-id"))
+			Expect(conditions.IsTrue(yc, infrav1.NodeSecurityGroupReadyCondition)).To(BeTrue())
+		})
+
+		It("should create NAT gateway and route table for managed network subnets", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.NetworkSpec = infrav1.NetworkSpec{
+				Subnets: []infrav1.NetworkSubnetSpec{
+					{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+				},
+				NATGateway: &infrav1.NATGatewaySpec{},
+			}
+			yc.Spec.LoadBalancer.Listener.Subnet = infrav1.SubnetSpec{ZoneID: "ru-central1-a"}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+
+			// reconciler sets finalizer here.
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			networkName := e.clusterName + "-network"
+			e.setNewNetworkReconcileMocks(networkName, networkName+"-ru-central1-a", "network-id", "subnet-id")
+			e.setNewNATGatewayReconcileMocks("gateway-id", "route-table-id")
+			e.setNewNLBReconcileMocks("1.2.3.4")
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Status.Network.NATGatewayID).To(Equal("gateway-id"))
+			Expect(yc.Status.Network.RouteTableID).To(Equal("route-table-id"))
+		})
+
 		It("should create load balancer with correct listener address if it does not exists and set ready status", func() {
 			ip := "1.2.3.4"
 
@@ -301,6 +557,43 @@ var _ = Describe("YandexCluster reconciliation check", func() {
 			Expect(yc.Status.LoadBalancer.ListenerPort).To(Equal(int32(8443)))
 		})
 
+		It("should set controlplane endpoint to the public address when load balancer with external listener exists", func() {
+			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
+			Expect(e.Create(ctx, cc)).To(Succeed())
+			yc := e.getYandexClusterWithOwnerReference(testNamespace.Name)
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client:       k8sClient,
+				YandexClient: e.mockClient,
+				Config:       config,
+			}
+			req := e.getReconcileRequest(yc.Namespace, yc.Name)
+
+			// reconciler sets finalizer here.
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+
+			ip := "51.250.1.2"
+			e.setExistingExternalALBReconcileMocks(ip)
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			yc = &infrav1.YandexCluster{}
+			Eventually(func() bool {
+				key := client.ObjectKey{
+					Name:      e.clusterName,
+					Namespace: testNamespace.Name,
+				}
+				err := e.Get(ctx, key, yc)
+				return (err == nil && yc.Status.Ready)
+			}, e.eventuallyTimeout).Should(BeTrue())
+
+			Expect(yc.Spec.ControlPlaneEndpoint.Host).To(Equal(ip))
+			Expect(yc.Status.LoadBalancer.ListenerAddress).To(Equal(ip))
+		})
+
 		It("should error when load balancer exists and listener spec not empty and differ from existed load balancer", func() {
 			cc := e.getCAPIClusterWithInfrastructureReference(testNamespace.Name)
 			Expect(e.Create(ctx, cc)).To(Succeed())
@@ -323,6 +616,7 @@ var _ = Describe("YandexCluster reconciliation check", func() {
 			existLoadBalancerIP := "1.2.3.4"
 			mockID, mockName := "123", "alb"
 
+			e.setExistingSecurityGroupMocks()
 			gomock.InOrder(
 				e.mockClient.EXPECT().
 					ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -413,6 +707,7 @@ var _ = Describe("YandexCluster reconciliation check", func() {
 
 			// mocks
 			mockID := "123"
+			e.setExistingSecurityGroupMocks()
 			gomock.InOrder(
 				e.mockClient.EXPECT().ALBTargetGroupGetByName(gomock.Any(), gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, id, name string) (*alb.TargetGroup, error) {
@@ -767,6 +1062,17 @@ var _ = Describe("YandexCluster deletion check", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result.RequeueAfter).To(Equal(RequeueDuration))
 
+			// Security group deletion.
+			sgID := lbID
+			sgName := builders.NewSecurityGroupBuilder(clusterScope.GetLBSpec()).
+				WithLBName(clusterScope.GetLBName()).
+				GetName()
+
+			e.setExistingSecurityGroupDeleteMocks(lbName, sgName, sgID)
+			result, err = reconciler.reconcileDelete(ctx, clusterScope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
 			// No load balancer resources exists in YandexCloud at this moment.
 			// We have to finish delete reconciliation and remove finalizer from YandexCLuster.
 			e.setNonExistingALBDeleteMocks(lbName)
@@ -776,5 +1082,135 @@ var _ = Describe("YandexCluster deletion check", func() {
 			Expect(result.Requeue).To(BeFalse())
 			Expect(clusterScope.Close(ctx)).Error().NotTo(HaveOccurred())
 		})
+
+		It("should delete an YandexCluster and remove managed network from YandexCloud after load balancer", func() {
+			yc := e.getYandexCluster(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.NetworkSpec = infrav1.NetworkSpec{
+				Subnets: []infrav1.NetworkSubnetSpec{
+					{ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+				},
+				NATGateway: &infrav1.NATGatewaySpec{},
+			}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client: k8sClient,
+				Config: config,
+			}
+
+			clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+				Client:        e.Client,
+				Cluster:       e.getCAPIClusterWithInfrastructureReference(testNamespace.Name),
+				YandexCluster: yc,
+				YandexClient:  e.mockClient,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			controllerutil.AddFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)
+
+			networkName := clusterScope.GetNetworkName()
+			e.setNonExistingNLBDeleteMocks(clusterScope.GetLBName())
+			e.setExistingNetworkDeleteMocks(networkName, networkName+"-ru-central1-a", "network-id", "subnet-id")
+			e.setExistingNATGatewayDeleteMocks("gateway-id", "route-table-id")
+			result, err := reconciler.reconcileDelete(ctx, clusterScope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controllerutil.ContainsFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)).To(BeFalse())
+			Expect(result.Requeue).To(BeFalse())
+			Expect(clusterScope.Close(ctx)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should delete an YandexCluster and remove control plane placement group from YandexCloud", func() {
+			yc := e.getYandexCluster(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.ControlPlanePlacementGroup = &infrav1.PlacementGroupSpec{Name: "control-plane-group"}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client: k8sClient,
+				Config: config,
+			}
+
+			clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+				Client:        e.Client,
+				Cluster:       e.getCAPIClusterWithInfrastructureReference(testNamespace.Name),
+				YandexCluster: yc,
+				YandexClient:  e.mockClient,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			controllerutil.AddFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)
+
+			e.setNonExistingNLBDeleteMocks(clusterScope.GetLBName())
+			e.setExistingPlacementGroupDeleteMocks("control-plane-group", "placement-group-id")
+			result, err := reconciler.reconcileDelete(ctx, clusterScope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controllerutil.ContainsFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)).To(BeFalse())
+			Expect(result.Requeue).To(BeFalse())
+			Expect(clusterScope.Close(ctx)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should delete an YandexCluster and remove node security group from YandexCloud", func() {
+			yc := e.getYandexCluster(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			yc.Spec.NodeSecurityGroup = &infrav1.SecurityGroupSpec{Name: "nodes-group"}
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client: k8sClient,
+				Config: config,
+			}
+
+			clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+				Client:        e.Client,
+				Cluster:       e.getCAPIClusterWithInfrastructureReference(testNamespace.Name),
+				YandexCluster: yc,
+				YandexClient:  e.mockClient,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			controllerutil.AddFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)
+
+			e.setNonExistingNLBDeleteMocks(clusterScope.GetLBName())
+			e.setExistingNodeSecurityGroupDeleteMocks("nodes-group", "security-group-id")
+			result, err := reconciler.reconcileDelete(ctx, clusterScope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controllerutil.ContainsFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)).To(BeFalse())
+			Expect(result.Requeue).To(BeFalse())
+			Expect(clusterScope.Close(ctx)).Error().NotTo(HaveOccurred())
+		})
+
+		It("should delete an YandexCluster and remove network load balancer from YandexCloud, if it exists", func() {
+			yc := e.getYandexCluster(testNamespace.Name)
+			yc.Spec.LoadBalancer.Type = infrav1.LoadBalancerTypeNLB
+			Expect(e.Create(ctx, yc)).To(Succeed())
+
+			reconciler := &YandexClusterReconciler{
+				Client: k8sClient,
+				Config: config,
+			}
+
+			clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+				Client:        e.Client,
+				Cluster:       e.getCAPIClusterWithInfrastructureReference(testNamespace.Name),
+				YandexCluster: yc,
+				YandexClient:  e.mockClient,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			controllerutil.AddFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)
+			lbName := clusterScope.GetLBName()
+
+			e.setExistingNLBDeleteMocks(lbName, "123")
+			result, err := reconciler.reconcileDelete(ctx, clusterScope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+			// No load balancer resources exists in YandexCloud at this moment.
+			// We have to finish delete reconciliation and remove finalizer from YandexCLuster.
+			e.setNonExistingNLBDeleteMocks(lbName)
+			result, err = reconciler.reconcileDelete(ctx, clusterScope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controllerutil.ContainsFinalizer(clusterScope.YandexCluster, infrav1.ClusterFinalizer)).To(BeFalse())
+			Expect(result.Requeue).To(BeFalse())
+			Expect(clusterScope.Close(ctx)).Error().NotTo(HaveOccurred())
+		})
 	})
 })
@@ -28,6 +28,7 @@ import (
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,8 +40,11 @@ import (
 	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
 	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/bootstrap"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/compute"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/storage"
 )
 
 const (
@@ -53,6 +57,11 @@ type YandexMachineReconciler struct {
 	client.Client
 	Scheme       *runtime.Scheme
 	YandexClient yandex.Client
+	ClientCache  *yandex.ClientCache
+	// BootstrapStorage is the object storage client the bootstrap data is delivered through.
+	// If it is nil, the bootstrap data is passed in the instance metadata.
+	BootstrapStorage storage.Client
+	Config           options.Config
 }
 
 //+kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
@@ -60,6 +69,8 @@ type YandexMachineReconciler struct {
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexmachines,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexmachines/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexmachines/finalizers,verbs=update
+//+kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
 
 // Reconcile brings YandexMachine into desired state.
 func (r *YandexMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -113,6 +124,7 @@ func (r *YandexMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		Cluster:       cluster,
 		YandexCluster: yandexCluster,
 		YandexClient:  r.YandexClient,
+		ClientCache:   r.ClientCache,
 	})
 	if err != nil {
 		return ctrl.Result{}, err
@@ -159,10 +171,40 @@ func (r *YandexMachineReconciler) reconcile(ctx context.Context, machineScope *s
 		return ctrl.Result{}, nil
 	}
 
+	// The addresses from IPAM pools are needed to create the instance only.
+	if machineScope.GetInstanceID() == "" && machineScope.HasAddressesFromPools() {
+		allocated, err := machineScope.ReconcileIPAddressClaims(ctx)
+		if err != nil {
+			conditions.MarkFalse(machineScope.YandexMachine, infrav1.IPAddressClaimedCondition,
+				infrav1.IPAddressClaimFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, fmt.Errorf("error reconciling ip address claims: %w", err)
+		}
+		if !allocated {
+			logger.Info("waiting for ip addresses to be allocated from IPAM pools")
+			conditions.MarkFalse(machineScope.YandexMachine, infrav1.IPAddressClaimedCondition,
+				infrav1.WaitingForIPAddressReason, clusterv1.ConditionSeverityInfo, "")
+			return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+		}
+		conditions.MarkTrue(machineScope.YandexMachine, infrav1.IPAddressClaimedCondition)
+	}
+
+	if r.BootstrapStorage != nil {
+		if err := bootstrap.New(machineScope, r.BootstrapStorage).Reconcile(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error reconciling bootstrap data object: %w", err)
+		}
+	}
+
 	if err := compute.New(machineScope).Reconcile(ctx); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error reconciling instance resources: %w", err)
 	}
 
+	// The instance is stopped and started again during the in-place resize, so its state is not a failure.
+	if machineScope.IsInstanceResizing() {
+		logger.Info("YandexMachine instance is resizing", "instance-id", machineScope.GetInstanceID())
+		machineScope.SetNotReady()
+		return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+	}
+
 	instanceState := *machineScope.GetInstanceStatus()
 	switch instanceState {
 	case infrav1.InstanceStatusStarting, infrav1.InstanceStatusProvisioning:
@@ -171,7 +213,15 @@ func (r *YandexMachineReconciler) reconcile(ctx context.Context, machineScope *s
 	case infrav1.InstanceStatusRunning:
 		logger.Info("YandexMachine instance is running", "instance-id", machineScope.GetInstanceID())
 		machineScope.SetReady()
-		return ctrl.Result{}, nil
+		// The running instance is checked periodically, so the changes made outside of the provider are reported.
+		return ctrl.Result{RequeueAfter: r.Config.DriftCheckInterval}, nil
+	case infrav1.InstanceStatusStopped:
+		if machineScope.IsPreemptible() {
+			// failure is already set by the compute service, the machine is to be replaced.
+			logger.Info("YandexMachine preemptible instance is preempted", "instance-id", machineScope.GetInstanceID())
+			return ctrl.Result{}, nil
+		}
+		fallthrough
 	default:
 		machineScope.SetFailureReason(capierrors.UpdateMachineError)
 		machineScope.SetFailureMessage(errors.Errorf("YandexMachine instance state %s is unexpected", instanceState))
@@ -184,6 +234,12 @@ func (r *YandexMachineReconciler) reconcileDelete(ctx context.Context, machineSc
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("reconciling YandexMachine delete")
 
+	if r.BootstrapStorage != nil {
+		if _, err := bootstrap.New(machineScope, r.BootstrapStorage).Delete(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting bootstrap data object: %w", err)
+		}
+	}
+
 	deleted, err := compute.New(machineScope).Delete(ctx)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("error deleting instance resources: %w", err)
@@ -23,14 +23,19 @@ import (
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client/mock_client"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
 )
 
 var _ = Describe("YandexMachine reconciliation check", func() {
@@ -317,6 +322,305 @@ var _ = Describe("YandexMachine reconciliation check", func() {
 		Expect(ym.Status.FailureMessage).ToNot(BeNil())
 	})
 
+	It("should resolve boot disk image family and record the image in status", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Spec.BootDisk.ImageID = ""
+		ym.Spec.BootDisk.ImageFamily = &infrav1.ImageFamily{Name: "ubuntu-2204-lts", FolderID: "standard-images"}
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setYandexMachineImageFamilyReconcileMocks("standard-images", "ubuntu-2204-lts", "image-id")
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			key := client.ObjectKey{
+				Name:      e.machineName,
+				Namespace: testNamespace.Name,
+			}
+			err = e.Get(ctx, key, ym)
+			return (err == nil &&
+				ym.Status.InstanceStatus != nil &&
+				*ym.Status.InstanceStatus == infrav1.InstanceStatusStarting)
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(ym.Status.BootImageID).To(Equal("image-id"))
+	})
+
+	It("should create yandex cloud instance with reserved public address", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Spec.NetworkInterfaces[0].HasPublicIP = ptr.To(true)
+		ym.Spec.NetworkInterfaces[0].PublicAddressID = "e9babcdefgh123456789"
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setYandexMachinePublicAddressReconcileMocks("e9babcdefgh123456789", "158.160.0.10")
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+	})
+
+	It("should create yandex cloud instance with address claimed from IPAM pool", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		poolRef := corev1.TypedLocalObjectReference{
+			APIGroup: ptr.To(ipamv1.GroupVersion.Group),
+			Kind:     "InClusterIPPool",
+			Name:     "pool",
+		}
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Spec.NetworkInterfaces[0].AddressFromPool = &poolRef
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		By("waiting for the address to be allocated")
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+		claim := &ipamv1.IPAddressClaim{}
+		claimKey := client.ObjectKey{Namespace: testNamespace.Name, Name: scope.GetIPAddressClaimName(e.machineName, 0)}
+		Expect(e.Get(ctx, claimKey, claim)).To(Succeed())
+		Expect(claim.Spec.PoolRef).To(Equal(poolRef))
+
+		By("allocating the address as IPAM provider does")
+		address := &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-address", Namespace: testNamespace.Name},
+			Spec: ipamv1.IPAddressSpec{
+				ClaimRef: corev1.LocalObjectReference{Name: claim.Name},
+				PoolRef:  poolRef,
+				Address:  "10.0.0.10",
+				Prefix:   24,
+			},
+		}
+		Expect(e.Create(ctx, address)).To(Succeed())
+		claim.Status.AddressRef = corev1.LocalObjectReference{Name: address.Name}
+		Expect(e.Status().Update(ctx, claim)).To(Succeed())
+
+		e.setYandexMachineAddressFromPoolReconcileMocks("10.0.0.10")
+		result, err = reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			key := client.ObjectKey{
+				Name:      e.machineName,
+				Namespace: testNamespace.Name,
+			}
+			err = e.Get(ctx, key, ym)
+			return err == nil && conditions.IsTrue(ym, infrav1.IPAddressClaimedCondition)
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(ym.Spec.ProviderID).To(Equal(ptr.To(scope.ProviderIDPrefix + "123")))
+	})
+
+	It("should remove bootstrap data from instance metadata after node has joined", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		machine := e.getMachineWithInfrastructureRef(testNamespace.Name)
+		Expect(e.Create(ctx, machine)).To(Succeed())
+		machine.Status.NodeRef = &corev1.ObjectReference{Kind: "Node", Name: e.machineName}
+		Expect(e.Status().Update(ctx, machine)).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Spec.ProviderID = ptr.To(scope.ProviderIDPrefix + "123")
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setRunningYandexMachinePurgeBootstrapDataMocks("123", "1.2.3.4")
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			key := client.ObjectKey{
+				Name:      e.machineName,
+				Namespace: testNamespace.Name,
+			}
+			err = e.Get(ctx, key, ym)
+			return err == nil && ym.Status.Ready
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(conditions.IsTrue(ym, infrav1.BootstrapDataPurgedCondition)).To(BeTrue())
+	})
+
+	It("should restore drifted instance labels and metadata", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Spec.ProviderID = ptr.To(scope.ProviderIDPrefix + "123")
+		ym.Spec.Metadata = map[string]string{"serial-port-enable": "1"}
+		ym.Spec.RestoreDrift = true
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+			Config:       options.Config{DriftCheckInterval: 5 * time.Minute},
+		}
+
+		e.setRunningYandexMachineRestoreDriftMocks("123", "1.2.3.4")
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(5 * time.Minute))
+
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			key := client.ObjectKey{
+				Name:      e.machineName,
+				Namespace: testNamespace.Name,
+			}
+			err = e.Get(ctx, key, ym)
+			return err == nil && ym.Status.Ready
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(conditions.IsTrue(ym, infrav1.InstanceSpecInSyncCondition)).To(BeTrue())
+	})
+
+	It("should resize yandex cloud instance in place", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Annotations = map[string]string{infrav1.InPlaceResizeAnnotation: "true"}
+		ym.Spec.ProviderID = ptr.To(scope.ProviderIDPrefix + "123")
+		ym.Spec.Resources.Cores = 2
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setYandexMachineInPlaceResizeMocks("123", "1.2.3.4")
+		key := client.ObjectKey{
+			Name:      e.machineName,
+			Namespace: testNamespace.Name,
+		}
+		for _, reason := range []string{
+			infrav1.InstanceStoppingForResizeReason,
+			infrav1.InstanceUpdatingResourcesReason,
+			infrav1.InstanceStartingAfterResizeReason,
+		} {
+			result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+			ym = &infrav1.YandexMachine{}
+			Eventually(func() bool {
+				err = e.Get(ctx, key, ym)
+				return err == nil && conditions.GetReason(ym, infrav1.InstanceResizedCondition) == reason
+			}, e.reconcileTimeout).Should(BeTrue())
+			Expect(ym.Status.Ready).To(BeFalse())
+		}
+
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			err = e.Get(ctx, key, ym)
+			return err == nil && ym.Status.Ready
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(conditions.IsTrue(ym, infrav1.InstanceResizedCondition)).To(BeTrue())
+	})
+
+	It("should deliver bootstrap data through object storage", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		bootstrapStorage := &fakeStorage{objects: make(map[string][]byte)}
+		reconciler := &YandexMachineReconciler{
+			Client:           k8sClient,
+			YandexClient:     e.mockClient,
+			BootstrapStorage: bootstrapStorage,
+		}
+
+		e.setYandexMachineBootstrapStorageReconcileMocks()
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			key := client.ObjectKey{
+				Name:      e.machineName,
+				Namespace: testNamespace.Name,
+			}
+			err = e.Get(ctx, key, ym)
+			return err == nil && ym.Status.BootstrapDataObject != ""
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(bootstrapStorage.objects).To(HaveKeyWithValue(ym.Status.BootstrapDataObject, []byte("verysecretdata")))
+	})
+
+	It("should fail preemptible machine when yandex cloud instance was preempted", func() {
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachineWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ym := e.getYandexMachineWithOwnerRef(testNamespace.Name)
+		ym.Spec.Preemptible = ptr.To(true)
+		Expect(e.Create(ctx, ym)).To(Succeed())
+
+		reconciler := &YandexMachineReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setYandexMachinePreemptedReconcileMocks()
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ym.Namespace, ym.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		ym = &infrav1.YandexMachine{}
+		Eventually(func() bool {
+			key := client.ObjectKey{
+				Name:      e.machineName,
+				Namespace: testNamespace.Name,
+			}
+			err = e.Get(ctx, key, ym)
+			return (err == nil &&
+				ym.Status.InstanceStatus != nil &&
+				*ym.Status.InstanceStatus == infrav1.InstanceStatusStopped)
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(ym.Status.Ready).To(BeFalse())
+		Expect(ym.Status.FailureReason).ToNot(BeNil())
+		Expect(ym.Status.FailureMessage).ToNot(BeNil())
+		Expect(conditions.GetReason(ym, infrav1.ConditionStatusRunning)).To(Equal(infrav1.InstancePreemptedReason))
+	})
+
 	It("should error and retry to add node to ALB target group on load balancer api error", func() {
 		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
 		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
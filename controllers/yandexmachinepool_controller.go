@@ -0,0 +1,222 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	exputil "sigs.k8s.io/cluster-api/exp/util"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/instancegroup"
+)
+
+// YandexMachinePoolReconciler reconciles a YandexMachinePool object.
+type YandexMachinePoolReconciler struct {
+	client.Client
+	Scheme       *runtime.Scheme
+	YandexClient yandex.Client
+	ClientCache  *yandex.ClientCache
+}
+
+//+kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+//+kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexmachinepools,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexmachinepools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=yandexmachinepools/finalizers,verbs=update
+
+// Reconcile brings YandexMachinePool into desired state.
+func (r *YandexMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	logger := log.FromContext(ctx)
+
+	yandexMachinePool := &infrav1.YandexMachinePool{}
+	err := r.Get(ctx, req.NamespacedName, yandexMachinePool)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("error occurred while fetching YandexMachinePool resource: %w", err)
+	}
+
+	logger.V(1).Info("machine pool found")
+	machinePool, err := exputil.GetOwnerMachinePool(ctx, r.Client, yandexMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machinePool == nil {
+		logger.Info("machine pool controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("machinePool", machinePool.Name)
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		logger.Info("machine pool is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	logger = logger.WithValues("cluster", cluster.Name)
+	if annotations.IsPaused(cluster, yandexMachinePool) {
+		logger.Info("YandexMachinePool or linked Cluster is marked as paused. Won't reconcile")
+		return ctrl.Result{}, nil
+	}
+
+	yandexCluster := &infrav1.YandexCluster{}
+	yandexClusterKey := client.ObjectKey{
+		Namespace: yandexMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err = r.Client.Get(ctx, yandexClusterKey, yandexCluster); err != nil {
+		logger.Info("YandexCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+		Client:        r.Client,
+		Cluster:       cluster,
+		YandexCluster: yandexCluster,
+		YandexClient:  r.YandexClient,
+		ClientCache:   r.ClientCache,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	machinePoolScope, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
+		Client:            r.Client,
+		MachinePool:       machinePool,
+		YandexMachinePool: yandexMachinePool,
+		ClusterGetter:     clusterScope,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// always close the scope when exiting this function so we can persist any YandexMachinePool changes.
+	defer func() {
+		if err := machinePoolScope.Close(); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if !yandexMachinePool.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, machinePoolScope)
+	}
+
+	return r.reconcile(ctx, machinePoolScope)
+}
+
+// reconcile it is a part of reconciliation loop in case of yandexmachinepool update/create.
+func (r *YandexMachinePoolReconciler) reconcile(ctx context.Context, machinePoolScope *scope.MachinePoolScope) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("reconciling YandexMachinePool")
+
+	controllerutil.AddFinalizer(machinePoolScope.YandexMachinePool, infrav1.MachinePoolFinalizer)
+
+	if err := machinePoolScope.PatchObject(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+	}
+
+	if !machinePoolScope.HasBootstrapData() {
+		logger.Info("bootstrap data is not ready yet: linked MachinePool's bootstrap.dataSecretName is nil. Skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	if err := instancegroup.New(machinePoolScope).Reconcile(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error reconciling instance group resources: %w", err)
+	}
+
+	if !machinePoolScope.YandexMachinePool.Status.Ready ||
+		!conditions.IsTrue(machinePoolScope.YandexMachinePool, infrav1.InstanceGroupReadyCondition) {
+		logger.Info("YandexMachinePool instance group is provisioning", "instance-group-id", machinePoolScope.GetInstanceGroupID())
+		return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+	}
+
+	logger.Info("YandexMachinePool instance group is ready", "instance-group-id", machinePoolScope.GetInstanceGroupID())
+	return ctrl.Result{}, nil
+}
+
+// reconcileDelete it is a part of reconciliation loop in case of yandexmachinepool delete.
+func (r *YandexMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.MachinePoolScope) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("reconciling YandexMachinePool delete")
+
+	deleted, err := instancegroup.New(machinePoolScope).Delete(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting instance group resources: %w", err)
+	}
+
+	if deleted {
+		logger.Info("YandexMachinePool instance group is deleted", "instance-group-id", machinePoolScope.GetInstanceGroupID())
+		controllerutil.RemoveFinalizer(machinePoolScope.YandexMachinePool, infrav1.MachinePoolFinalizer)
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("YandexMachinePool instance group is deleting", "instance-group-id", machinePoolScope.GetInstanceGroupID())
+	return ctrl.Result{RequeueAfter: RequeueDuration}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *YandexMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.YandexMachinePool{}).
+		WithEventFilter(predicates.ResourceNotPaused(ctrl.LoggerFrom(ctx))).
+		Watches(
+			&expclusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(exputil.MachinePoolToInfrastructureMapFunc(
+				infrav1.GroupVersion.WithKind("YandexMachinePool"), ctrl.LoggerFrom(ctx))),
+		).
+		Build(r)
+	if err != nil {
+		return errors.Wrapf(err, "error creating controller")
+	}
+
+	clusterToObjectFunc, err := util.ClusterToTypedObjectsMapper(r.Client, &infrav1.YandexMachinePoolList{}, mgr.GetScheme())
+	if err != nil {
+		return errors.Wrapf(err, "failed to create mapper for Cluster to YandexMachinePools")
+	}
+
+	// add a watch on clusterv1.Cluster object for unpause & ready notifications.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &clusterv1.Cluster{}),
+		handler.EnqueueRequestsFromMapFunc(clusterToObjectFunc),
+		predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+	); err != nil {
+		return errors.Wrapf(err, "failed adding a watch for ready clusters")
+	}
+
+	return nil
+}
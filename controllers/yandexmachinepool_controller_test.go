@@ -0,0 +1,201 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers //nolint:testpackage // private variables access
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client/mock_client"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	"go.uber.org/mock/gomock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+)
+
+var _ = Describe("YandexMachinePool reconciliation check", func() {
+	BeforeEach(func() {
+		var err error
+
+		e = ClusterTestEnv{
+			Client:           k8sClient,
+			clusterName:      "machinepool-check-cluster",
+			machineName:      "test",
+			secretName:       "test",
+			reconcileTimeout: 3 * time.Second,
+		}
+		e.controller = gomock.NewController(GinkgoT())
+		e.mockClient = mock_client.NewMockClient(e.controller)
+		testNamespace, err = e.CreateNamespace(ctx, "machinepool-check")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(e.DeleteNamespace(ctx)).To(Succeed())
+		e.controller.Finish()
+	})
+
+	It("should not reconcile YandexMachinePool without parent CAPI MachinePool", func() {
+		ymp := e.getYandexMachinePool(testNamespace.Name)
+		Expect(e.Create(ctx, ymp)).To(Succeed())
+
+		reconciler := &YandexMachinePoolReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ymp.Namespace, ymp.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(result.Requeue).To(BeFalse())
+	})
+
+	It("should create instance group and get ready status eventually", func() {
+		const (
+			instanceGroupID = "ig-123"
+			instanceID      = "instance-123"
+		)
+
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachinePoolWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getBootstrapSecret(testNamespace.Name))).To(Succeed())
+		ymp := e.getYandexMachinePoolWithOwnerRef(testNamespace.Name)
+		Expect(e.Create(ctx, ymp)).To(Succeed())
+
+		reconciler := &YandexMachinePoolReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setNewYandexMachinePoolReconcileMocks(instanceGroupID, instanceID)
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ymp.Namespace, ymp.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+		// On the first reconcile the instance group have to be created, but not ready.
+		key := client.ObjectKey{Name: e.machineName, Namespace: testNamespace.Name}
+		ymp = &infrav1.YandexMachinePool{}
+		Eventually(func() bool {
+			err := e.Get(ctx, key, ymp)
+			return err == nil && ymp.Spec.ProviderID == scope.ProviderIDPrefix+instanceGroupID
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(ymp.Status.Ready).To(BeFalse())
+		Expect(ymp.GetFinalizers()).To(Equal([]string{infrav1.MachinePoolFinalizer}))
+
+		result, err = reconciler.Reconcile(ctx, e.getReconcileRequest(ymp.Namespace, ymp.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		// On the second reconcile the instance group instances have to be published.
+		ymp = &infrav1.YandexMachinePool{}
+		Eventually(func() bool {
+			err := e.Get(ctx, key, ymp)
+			return err == nil && ymp.Status.Ready
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(ymp.Status.Replicas).To(Equal(int32(1)))
+		Expect(ymp.Spec.ProviderIDList).To(Equal([]string{scope.ProviderIDPrefix + instanceID}))
+	})
+
+	It("should deliver refreshed bootstrap data to instance group without recreating instances", func() {
+		const (
+			instanceGroupID = "ig-123"
+			instanceID      = "instance-123"
+		)
+
+		Expect(e.Create(ctx, e.getCAPIClusterWithInfrastructureReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getYandexClusterWithOwnerReference(testNamespace.Name))).To(Succeed())
+		Expect(e.Create(ctx, e.getMachinePoolWithInfrastructureRef(testNamespace.Name))).To(Succeed())
+		secret := e.getBootstrapSecret(testNamespace.Name)
+		Expect(e.Create(ctx, secret)).To(Succeed())
+		ymp := e.getYandexMachinePoolWithOwnerRef(testNamespace.Name)
+		Expect(e.Create(ctx, ymp)).To(Succeed())
+
+		reconciler := &YandexMachinePoolReconciler{
+			Client:       k8sClient,
+			YandexClient: e.mockClient,
+		}
+
+		e.setYandexMachinePoolBootstrapRefreshMocks(instanceGroupID, instanceID)
+		_, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ymp.Namespace, ymp.Name))
+		Expect(err).NotTo(HaveOccurred())
+
+		key := client.ObjectKey{Name: e.machineName, Namespace: testNamespace.Name}
+		Eventually(func() bool {
+			err := e.Get(ctx, key, ymp)
+			return err == nil && ymp.Spec.ProviderID == scope.ProviderIDPrefix+instanceGroupID
+		}, e.reconcileTimeout).Should(BeTrue())
+
+		// The join token is refreshed by the bootstrap provider.
+		secret.Data["value"] = []byte("refreshedsecretdata")
+		Expect(e.Update(ctx, secret)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, e.getReconcileRequest(ymp.Namespace, ymp.Name))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+
+		ymp = &infrav1.YandexMachinePool{}
+		Eventually(func() bool {
+			err := e.Get(ctx, key, ymp)
+			return err == nil && len(ymp.Spec.ProviderIDList) == 1
+		}, e.reconcileTimeout).Should(BeTrue())
+		Expect(ymp.Status.Replicas).To(Equal(int32(1)))
+	})
+
+	It("should delete instance group and remove finalizer", func() {
+		const instanceGroupID = "ig-123"
+
+		ymp := e.getYandexMachinePoolWithOwnerRef(testNamespace.Name)
+		controllerutil.AddFinalizer(ymp, infrav1.MachinePoolFinalizer)
+
+		reconciler := &YandexMachinePoolReconciler{
+			Client:       e.Client,
+			YandexClient: e.mockClient,
+		}
+
+		clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+			Client:        e.Client,
+			Cluster:       e.getCAPIClusterWithInfrastructureReference(testNamespace.Name),
+			YandexCluster: e.getYandexClusterWithOwnerReference(testNamespace.Name),
+			YandexClient:  e.mockClient,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		machinePoolScope, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
+			Client:            e.Client,
+			MachinePool:       e.getMachinePoolWithInfrastructureRef(testNamespace.Name),
+			ClusterGetter:     clusterScope,
+			YandexMachinePool: ymp,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		e.setYandexMachinePoolDeleteMocks(instanceGroupID)
+
+		// The instance group deletion is requested, so the finalizer should be kept.
+		result, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(RequeueDuration))
+		Expect(ymp.GetFinalizers()).To(ContainElement(infrav1.MachinePoolFinalizer))
+
+		result, err = reconciler.reconcileDelete(ctx, machinePoolScope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(ymp.GetFinalizers()).NotTo(ContainElement(infrav1.MachinePoolFinalizer))
+	})
+})
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// retiredClientGracePeriod is the time the client built from the rotated key is kept open,
+// so the reconciliations, which have got the client before the rotation, could finish their API calls.
+const retiredClientGracePeriod = 10 * time.Minute
+
+// idleClientTimeout is the time after which the client, which has not been requested, is retired,
+// so the clients of the deleted identities do not stay open forever.
+const idleClientTimeout = time.Hour
+
+// Builder builds an authentificated YandexCloud API client from the service account authorized key.
+type Builder func(ctx context.Context, key string) (Client, error)
+
+// ClientCache keeps the YandexCloud API clients built for the cluster identities,
+// so the SDK connections are not established on every reconciliation.
+type ClientCache struct {
+	mu      sync.Mutex
+	builder Builder
+	clients map[string]cachedClient
+	retired []retiredClient
+	now     func() time.Time
+}
+
+// cachedClient is the client built for an identity along with the hash of the key it was built from
+// and the time it was requested last.
+type cachedClient struct {
+	keyHash  string
+	client   Client
+	lastUsed time.Time
+}
+
+// retiredClient is the client built from the rotated key, which is closed after the grace period.
+type retiredClient struct {
+	client    Client
+	retiredAt time.Time
+}
+
+// NewClientCache returns an empty client cache which builds clients with the builder.
+func NewClientCache(builder Builder) *ClientCache {
+	return &ClientCache{
+		builder: builder,
+		clients: make(map[string]cachedClient),
+		now:     time.Now,
+	}
+}
+
+// Get returns the client of the identity, building a new one if the identity is not cached yet
+// or its key has been changed since the client was built.
+// The clients, which have not been requested for the idle timeout, are retired.
+func (c *ClientCache) Get(ctx context.Context, identity, key string) (Client, error) {
+	sum := sha256.Sum256([]byte(key))
+	keyHash := hex.EncodeToString(sum[:])
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.retireIdle()
+	c.closeRetired(ctx)
+	if cached, ok := c.clients[identity]; ok {
+		if cached.keyHash == keyHash {
+			cached.lastUsed = c.now()
+			c.clients[identity] = cached
+			return cached.client, nil
+		}
+		// The key was rotated, but the client built from the previous key could still be in use,
+		// so it is closed after the grace period only.
+		c.retired = append(c.retired, retiredClient{client: cached.client, retiredAt: c.now()})
+		delete(c.clients, identity)
+	}
+
+	client, err := c.builder(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.clients[identity] = cachedClient{keyHash: keyHash, client: client, lastUsed: c.now()}
+	return client, nil
+}
+
+// Close closes all cached and retired clients.
+func (c *ClientCache) Close(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for identity, cached := range c.clients {
+		_ = cached.client.Close(ctx)
+		delete(c.clients, identity)
+	}
+	for _, retired := range c.retired {
+		_ = retired.client.Close(ctx)
+	}
+	c.retired = nil
+}
+
+// retireIdle retires the clients, which have not been requested for the idle timeout.
+// Such clients are built for the deleted identities usually, but they are closed after the grace period only
+// as well as the clients built from the rotated keys.
+func (c *ClientCache) retireIdle() {
+	for identity, cached := range c.clients {
+		if c.now().Sub(cached.lastUsed) < idleClientTimeout {
+			continue
+		}
+		c.retired = append(c.retired, retiredClient{client: cached.client, retiredAt: c.now()})
+		delete(c.clients, identity)
+	}
+}
+
+// closeRetired closes the retired clients, which grace period has passed.
+func (c *ClientCache) closeRetired(ctx context.Context) {
+	active := c.retired[:0]
+	for _, retired := range c.retired {
+		if c.now().Sub(retired.retiredAt) < retiredClientGracePeriod {
+			active = append(active, retired)
+			continue
+		}
+		_ = retired.client.Close(ctx)
+	}
+	c.retired = active
+}
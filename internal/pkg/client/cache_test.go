@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// fakeClient is the client, which records whether it has been closed.
+type fakeClient struct {
+	Client
+	key    string
+	closed bool
+}
+
+func (f *fakeClient) Close(_ context.Context) error {
+	f.closed = true
+	return nil
+}
+
+func TestClientCache_KeyRotation(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	now := time.Now()
+	cache := NewClientCache(func(_ context.Context, key string) (Client, error) {
+		return &fakeClient{key: key}, nil
+	})
+	cache.now = func() time.Time { return now }
+
+	oldClient, err := cache.Get(ctx, "identity", "old-key")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	t.Run("Get should keep the client built from the rotated key open", func(_ *testing.T) {
+		newClient, err := cache.Get(ctx, "identity", "new-key")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(newClient.(*fakeClient).key).To(Equal("new-key"))
+		g.Expect(oldClient.(*fakeClient).closed).To(BeFalse())
+	})
+
+	t.Run("Get should close the client built from the rotated key after the grace period", func(_ *testing.T) {
+		now = now.Add(retiredClientGracePeriod)
+		_, err := cache.Get(ctx, "identity", "new-key")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(oldClient.(*fakeClient).closed).To(BeTrue())
+	})
+
+	t.Run("Close should close the cached and the retired clients", func(_ *testing.T) {
+		retiredClient, err := cache.Get(ctx, "identity", "new-key")
+		g.Expect(err).NotTo(HaveOccurred())
+		cachedClient, err := cache.Get(ctx, "identity", "newest-key")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(retiredClient.(*fakeClient).closed).To(BeFalse())
+
+		cache.Close(ctx)
+		g.Expect(retiredClient.(*fakeClient).closed).To(BeTrue())
+		g.Expect(cachedClient.(*fakeClient).closed).To(BeTrue())
+	})
+}
+
+func TestClientCache_IdleClient(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	now := time.Now()
+	cache := NewClientCache(func(_ context.Context, key string) (Client, error) {
+		return &fakeClient{key: key}, nil
+	})
+	cache.now = func() time.Time { return now }
+
+	deletedClient, err := cache.Get(ctx, "deleted-identity", "deleted-key")
+	g.Expect(err).NotTo(HaveOccurred())
+	usedClient, err := cache.Get(ctx, "identity", "key")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	t.Run("Get should keep the requested client cached", func(_ *testing.T) {
+		now = now.Add(idleClientTimeout / 2)
+		_, err := cache.Get(ctx, "identity", "key")
+		g.Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(idleClientTimeout / 2)
+		client, err := cache.Get(ctx, "identity", "key")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(client).To(BeIdenticalTo(usedClient))
+		g.Expect(deletedClient.(*fakeClient).closed).To(BeFalse())
+	})
+
+	t.Run("Get should close the idle client after the grace period", func(_ *testing.T) {
+		now = now.Add(retiredClientGracePeriod)
+		client, err := cache.Get(ctx, "identity", "key")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(client).To(BeIdenticalTo(usedClient))
+		g.Expect(deletedClient.(*fakeClient).closed).To(BeTrue())
+	})
+
+	t.Run("Get should build a new client for the evicted identity", func(_ *testing.T) {
+		client, err := cache.Get(ctx, "deleted-identity", "deleted-key")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(client).NotTo(BeIdenticalTo(deletedClient))
+	})
+}
@@ -4,10 +4,9 @@ package client
 
 import (
 	"context"
-	"encoding/json"
 
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
 	ycsdk "github.com/yandex-cloud/go-sdk"
-	"github.com/yandex-cloud/go-sdk/iamkey"
 )
 
 // YandexClient is a structure to access Yandex Cloud SDK.
@@ -17,19 +16,28 @@ type YandexClient struct {
 	sdk *ycsdk.SDK
 }
 
-// GetClient returns authentificated YandexClient.
+// GetClient returns YandexClient authentificated with the service account authorized key.
 func GetClient(ctx context.Context, key string) (Client, error) {
-	var SAKey iamkey.Key
-
-	if err := json.Unmarshal([]byte(key), &SAKey); err != nil {
+	credentials, err := serviceAccountKeyCredentials(key)
+	if err != nil {
 		return nil, err
 	}
 
-	credentials, err := ycsdk.ServiceAccountKey(&SAKey)
+	return GetClientWithCredentials(ctx, credentials)
+}
+
+// GetClientFromConfig returns YandexClient authentificated with the authentication mode of the config.
+func GetClientFromConfig(ctx context.Context, cfg options.Config) (Client, error) {
+	credentials, err := GetCredentials(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	return GetClientWithCredentials(ctx, credentials)
+}
+
+// GetClientWithCredentials returns YandexClient authentificated with the credentials.
+func GetClientWithCredentials(ctx context.Context, credentials ycsdk.Credentials) (Client, error) {
 	sdk, err := ycsdk.Build(ctx, ycsdk.Config{Credentials: credentials})
 	if err != nil {
 		return nil, err
@@ -8,10 +8,12 @@ import (
 )
 
 // ComputeGet returns Yandex Compute Instance by instance ID.
+// The instance is returned with its metadata, so it could be compared with the machine specification.
 func (c *YandexClient) ComputeGet(ctx context.Context, id string) (*compute.Instance, error) {
 	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelCompute)
 	ComputeInstance, err := c.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
 		InstanceId: id,
+		View:       compute.InstanceView_FULL,
 	})
 	mc.ObserveRequest(err)
 	return ComputeInstance, err
@@ -49,3 +51,78 @@ func (c *YandexClient) ComputeDelete(ctx context.Context, id string) error {
 
 	return err
 }
+
+// ComputeStop sends compute stop request to Yandex Cloud without waiting until the instance is stopped.
+func (c *YandexClient) ComputeStop(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelCompute)
+	_, err := c.sdk.Compute().Instance().Stop(ctx, &compute.StopInstanceRequest{
+		InstanceId: id,
+	})
+	mc.ObserveRequest(err)
+
+	return err
+}
+
+// ComputeStart sends compute start request to Yandex Cloud without waiting until the instance is started.
+func (c *YandexClient) ComputeStart(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelCompute)
+	_, err := c.sdk.Compute().Instance().Start(ctx, &compute.StartInstanceRequest{
+		InstanceId: id,
+	})
+	mc.ObserveRequest(err)
+
+	return err
+}
+
+// ComputeUpdate sends compute update request to Yandex Cloud and waits until the instance is updated.
+func (c *YandexClient) ComputeUpdate(ctx context.Context, req *compute.UpdateInstanceRequest) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelCompute)
+	op, err := c.sdk.Compute().Instance().Update(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// ComputeUpdateMetadata updates and removes the metadata keys of Yandex Compute Instance and waits until it is done.
+func (c *YandexClient) ComputeUpdateMetadata(
+	ctx context.Context, id string, upsert map[string]string, remove []string,
+) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelCompute)
+	op, err := c.sdk.Compute().Instance().UpdateMetadata(ctx, &compute.UpdateInstanceMetadataRequest{
+		InstanceId: id,
+		Upsert:     upsert,
+		Delete:     remove,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// DiskGet returns Yandex Compute Disk by disk ID.
+func (c *YandexClient) DiskGet(ctx context.Context, id string) (*compute.Disk, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelComputeDisk)
+	disk, err := c.sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{
+		DiskId: id,
+	})
+	mc.ObserveRequest(err)
+	return disk, err
+}
+
+// ImageGetLatestByFamily returns the latest Yandex Compute Image of the family in the folder.
+func (c *YandexClient) ImageGetLatestByFamily(ctx context.Context, folderID, family string) (*compute.Image, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelComputeImage)
+	image, err := c.sdk.Compute().Image().GetLatestByFamily(ctx, &compute.GetImageLatestByFamilyRequest{
+		FolderId: folderID,
+		Family:   family,
+	})
+	mc.ObserveRequest(err)
+	return image, err
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
+	iampb "github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/iamkey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// workloadIdentityTimeout is the timeout of the workload identity token exchange request.
+const workloadIdentityTimeout = 10 * time.Second
+
+// GetCredentials returns YandexCloud API credentials for the authentication mode of the config.
+func GetCredentials(cfg options.Config) (ycsdk.Credentials, error) {
+	switch cfg.AuthMode {
+	case options.AuthModeSAKey:
+		return serviceAccountKeyCredentials(cfg.YandexCloudSAKey)
+	case options.AuthModeInstanceSA:
+		return ycsdk.InstanceServiceAccount(), nil
+	case options.AuthModeIAMTokenFile:
+		return &iamTokenFileCredentials{path: cfg.TokenFile}, nil
+	case options.AuthModeOAuthTokenFile:
+		return &oauthTokenFileCredentials{path: cfg.TokenFile}, nil
+	case options.AuthModeWorkloadIdentity:
+		return &workloadIdentityCredentials{
+			path:             cfg.TokenFile,
+			serviceAccountID: cfg.WorkloadIdentityServiceAccountID,
+			tokenURL:         cfg.WorkloadIdentityTokenURL,
+			client:           http.Client{Timeout: workloadIdentityTimeout},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown authentication mode %q", cfg.AuthMode)
+	}
+}
+
+// serviceAccountKeyCredentials returns credentials for the service account authorized key in the JSON format.
+func serviceAccountKeyCredentials(key string) (ycsdk.Credentials, error) {
+	var SAKey iamkey.Key
+
+	if err := json.Unmarshal([]byte(key), &SAKey); err != nil {
+		return nil, err
+	}
+
+	return ycsdk.ServiceAccountKey(&SAKey)
+}
+
+// readTokenFile reads the token from the file, trimming the surrounding whitespaces.
+func readTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// iamTokenFileCredentials reads the IAM token from the file on every token request.
+// As the SDK caches the tokens without expiration time for a minute only,
+// the rotated token is picked up shortly.
+type iamTokenFileCredentials struct {
+	path string
+}
+
+var _ ycsdk.NonExchangeableCredentials = &iamTokenFileCredentials{}
+
+// YandexCloudAPICredentials implements ycsdk.Credentials.
+func (c *iamTokenFileCredentials) YandexCloudAPICredentials() {}
+
+// IAMToken implements ycsdk.NonExchangeableCredentials.
+func (c *iamTokenFileCredentials) IAMToken(_ context.Context) (*iampb.CreateIamTokenResponse, error) {
+	token, err := readTokenFile(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iampb.CreateIamTokenResponse{IamToken: token}, nil
+}
+
+// oauthTokenFileCredentials reads the OAuth token from the file every time it is exchanged for the IAM token.
+type oauthTokenFileCredentials struct {
+	path string
+}
+
+var _ ycsdk.ExchangeableCredentials = &oauthTokenFileCredentials{}
+
+// YandexCloudAPICredentials implements ycsdk.Credentials.
+func (c *oauthTokenFileCredentials) YandexCloudAPICredentials() {}
+
+// IAMTokenRequest implements ycsdk.ExchangeableCredentials.
+func (c *oauthTokenFileCredentials) IAMTokenRequest() (*iampb.CreateIamTokenRequest, error) {
+	token, err := readTokenFile(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iampb.CreateIamTokenRequest{
+		Identity: &iampb.CreateIamTokenRequest_YandexPassportOauthToken{YandexPassportOauthToken: token},
+	}, nil
+}
+
+// workloadIdentityCredentials exchanges the Kubernetes service account token for the IAM token
+// of the YandexCloud service account using workload identity federation.
+// More information https://yandex.cloud/ru/docs/iam/concepts/workload-identity .
+type workloadIdentityCredentials struct {
+	path             string
+	serviceAccountID string
+	tokenURL         string
+	client           http.Client
+}
+
+var _ ycsdk.NonExchangeableCredentials = &workloadIdentityCredentials{}
+
+// YandexCloudAPICredentials implements ycsdk.Credentials.
+func (c *workloadIdentityCredentials) YandexCloudAPICredentials() {}
+
+// IAMToken implements ycsdk.NonExchangeableCredentials.
+func (c *workloadIdentityCredentials) IAMToken(ctx context.Context) (*iampb.CreateIamTokenResponse, error) {
+	subjectToken, err := readTokenFile(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":           {"urn:ietf:params:oauth:grant-type:token-exchange"},
+		"requested_token_type": {"urn:ietf:params:oauth:token-type:access_token"},
+		"audience":             {c.serviceAccountID},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {"urn:ietf:params:oauth:token-type:id_token"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange workload identity token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workload identity token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to exchange workload identity token: %s: %s", resp.Status, body)
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse workload identity token response: %w", err)
+	}
+
+	if tokenResponse.AccessToken == "" {
+		return nil, fmt.Errorf("workload identity token response has no access token")
+	}
+
+	// Refresh the token a minute before the expiration, or at the half of its lifetime for the short-lived tokens,
+	// so the token is not exchanged again on every request.
+	lifetime := time.Duration(tokenResponse.ExpiresIn) * time.Second
+	refreshMargin := min(time.Minute, lifetime/2)
+	expiresAt := time.Now().Add(lifetime - refreshMargin)
+	return &iampb.CreateIamTokenResponse{
+		IamToken:  tokenResponse.AccessToken,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+func TestGetCredentials(t *testing.T) {
+	g := NewWithT(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).NotTo(HaveOccurred())
+	privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	g.Expect(err).NotTo(HaveOccurred())
+	saKey, err := json.Marshal(map[string]string{
+		"id":                 "key-id",
+		"service_account_id": "service-account-id",
+		"private_key":        string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDER})),
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tests := []struct {
+		name    string
+		config  options.Config
+		want    ycsdk.Credentials
+		wantErr bool
+	}{
+		{
+			name:   "service account key",
+			config: options.Config{AuthMode: options.AuthModeSAKey, YandexCloudSAKey: string(saKey)},
+		},
+		{
+			name:    "invalid service account key",
+			config:  options.Config{AuthMode: options.AuthModeSAKey, YandexCloudSAKey: "not a key"},
+			wantErr: true,
+		},
+		{
+			name:   "instance service account",
+			config: options.Config{AuthMode: options.AuthModeInstanceSA},
+		},
+		{
+			name:   "IAM token file",
+			config: options.Config{AuthMode: options.AuthModeIAMTokenFile, TokenFile: "/var/run/token"},
+			want:   &iamTokenFileCredentials{path: "/var/run/token"},
+		},
+		{
+			name:   "OAuth token file",
+			config: options.Config{AuthMode: options.AuthModeOAuthTokenFile, TokenFile: "/var/run/token"},
+			want:   &oauthTokenFileCredentials{path: "/var/run/token"},
+		},
+		{
+			name: "workload identity",
+			config: options.Config{
+				AuthMode:                         options.AuthModeWorkloadIdentity,
+				TokenFile:                        "/var/run/token",
+				WorkloadIdentityServiceAccountID: "service-account-id",
+				WorkloadIdentityTokenURL:         "https://auth.example.com/token",
+			},
+			want: &workloadIdentityCredentials{
+				path:             "/var/run/token",
+				serviceAccountID: "service-account-id",
+				tokenURL:         "https://auth.example.com/token",
+				client:           http.Client{Timeout: workloadIdentityTimeout},
+			},
+		},
+		{
+			name:    "unknown authentication mode",
+			config:  options.Config{AuthMode: "password"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			credentials, err := GetCredentials(test.config)
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(credentials).NotTo(BeNil())
+			if test.want != nil {
+				g.Expect(credentials).To(Equal(test.want))
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityCredentials_IAMToken(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	g.Expect(os.WriteFile(tokenFile, []byte("k8s-token\n"), 0o600)).To(Succeed())
+
+	var status int
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.ParseForm()).To(Succeed())
+		g.Expect(r.PostForm.Get("grant_type")).To(Equal("urn:ietf:params:oauth:grant-type:token-exchange"))
+		g.Expect(r.PostForm.Get("audience")).To(Equal("service-account-id"))
+		g.Expect(r.PostForm.Get("subject_token")).To(Equal("k8s-token"))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	credentials := &workloadIdentityCredentials{
+		path:             tokenFile,
+		serviceAccountID: "service-account-id",
+		tokenURL:         server.URL,
+		client:           http.Client{Timeout: workloadIdentityTimeout},
+	}
+
+	t.Run("IAMToken should exchange the service account token", func(_ *testing.T) {
+		status, response = http.StatusOK, `{"access_token":"iam-token","expires_in":3600}`
+		token, err := credentials.IAMToken(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(token.GetIamToken()).To(Equal("iam-token"))
+		g.Expect(token.GetExpiresAt().AsTime()).To(BeTemporally("~", time.Now().Add(59*time.Minute), time.Second))
+	})
+
+	t.Run("IAMToken should refresh short-lived token at the half of its lifetime", func(_ *testing.T) {
+		status, response = http.StatusOK, `{"access_token":"iam-token","expires_in":30}`
+		token, err := credentials.IAMToken(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(token.GetExpiresAt().AsTime()).To(BeTemporally("~", time.Now().Add(15*time.Second), time.Second))
+	})
+
+	t.Run("IAMToken should fail without access token", func(_ *testing.T) {
+		status, response = http.StatusOK, `{"expires_in":3600}`
+		_, err := credentials.IAMToken(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("no access token")))
+	})
+
+	t.Run("IAMToken should fail on error response", func(_ *testing.T) {
+		status, response = http.StatusBadRequest, `{"error":"invalid_grant"}`
+		_, err := credentials.IAMToken(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("invalid_grant")))
+	})
+
+	t.Run("IAMToken should fail without token file", func(_ *testing.T) {
+		credentials := *credentials
+		credentials.path = filepath.Join(t.TempDir(), "missing")
+		_, err := credentials.IAMToken(ctx)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
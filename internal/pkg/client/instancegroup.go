@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/metrics"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+)
+
+// InstanceGroupCreate sends instance group creation request to Yandex Cloud and returns InstanceGroup ID.
+func (c *YandexClient) InstanceGroupCreate(ctx context.Context, req *instancegroup.CreateInstanceGroupRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelInstanceGroup)
+	op, err := c.sdk.InstanceGroup().InstanceGroup().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	ig, err := c.sdk.WrapOperation(op, err)
+	if err != nil {
+		return "", err
+	}
+
+	meta, err := ig.Metadata()
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*instancegroup.CreateInstanceGroupMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get instance group ID from create operation metadata")
+	}
+
+	return md.GetInstanceGroupId(), nil
+}
+
+// InstanceGroupUpdate sends instance group update request to Yandex Cloud.
+// The instance group instances are updated according to the group deploy policy.
+func (c *YandexClient) InstanceGroupUpdate(ctx context.Context, req *instancegroup.UpdateInstanceGroupRequest) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelInstanceGroup)
+	_, err := c.sdk.InstanceGroup().InstanceGroup().Update(ctx, req)
+	mc.ObserveRequest(err)
+	return err
+}
+
+// InstanceGroupDelete sends instance group deletion request to Yandex Cloud.
+func (c *YandexClient) InstanceGroupDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelInstanceGroup)
+	_, err := c.sdk.InstanceGroup().InstanceGroup().Delete(ctx, &instancegroup.DeleteInstanceGroupRequest{
+		InstanceGroupId: id,
+	})
+	mc.ObserveRequest(err)
+	return err
+}
+
+// InstanceGroupGetByName returns InstanceGroup by name for the specified Folder ID.
+func (c *YandexClient) InstanceGroupGetByName(ctx context.Context, id, name string) (*instancegroup.InstanceGroup, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelInstanceGroup)
+	resp, err := c.sdk.InstanceGroup().InstanceGroup().List(ctx, &instancegroup.ListInstanceGroupsRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+		View:     instancegroup.InstanceGroupView_FULL,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.InstanceGroups) == 0 {
+		return nil, nil
+	}
+	return resp.InstanceGroups[0], nil
+}
+
+// InstanceGroupListInstances returns all instances of the instance group.
+func (c *YandexClient) InstanceGroupListInstances(ctx context.Context, id string) ([]*instancegroup.ManagedInstance, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelInstanceGroup)
+	instances, err := c.sdk.InstanceGroup().InstanceGroup().InstanceGroupInstancesIterator(ctx,
+		&instancegroup.ListInstanceGroupInstancesRequest{
+			InstanceGroupId: id,
+		}).TakeAll()
+	mc.ObserveRequest(err)
+	return instances, err
+}
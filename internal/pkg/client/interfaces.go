@@ -5,8 +5,10 @@ import (
 
 	alb "github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
 	nlb "github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 )
 
 // Compute defines interface for YandexCloud Compute operations.
@@ -14,6 +16,28 @@ type Compute interface {
 	ComputeGet(ctx context.Context, id string) (*compute.Instance, error)
 	ComputeCreate(ctx context.Context, req *compute.CreateInstanceRequest) (string, error)
 	ComputeDelete(ctx context.Context, id string) error
+	ComputeStop(ctx context.Context, id string) error
+	ComputeStart(ctx context.Context, id string) error
+	ComputeUpdate(ctx context.Context, req *compute.UpdateInstanceRequest) error
+	ComputeUpdateMetadata(ctx context.Context, id string, upsert map[string]string, remove []string) error
+	ImageGetLatestByFamily(ctx context.Context, folderID, family string) (*compute.Image, error)
+	DiskGet(ctx context.Context, id string) (*compute.Disk, error)
+}
+
+// InstanceGroup defines interface for YandexCloud Compute instance group operations.
+type InstanceGroup interface {
+	InstanceGroupCreate(ctx context.Context, req *instancegroup.CreateInstanceGroupRequest) (string, error)
+	InstanceGroupUpdate(ctx context.Context, req *instancegroup.UpdateInstanceGroupRequest) error
+	InstanceGroupDelete(ctx context.Context, id string) error
+	InstanceGroupGetByName(ctx context.Context, id, name string) (*instancegroup.InstanceGroup, error)
+	InstanceGroupListInstances(ctx context.Context, id string) ([]*instancegroup.ManagedInstance, error)
+}
+
+// PlacementGroup defines interface for YandexCloud Compute placement group operations.
+type PlacementGroup interface {
+	PlacementGroupCreate(ctx context.Context, req *compute.CreatePlacementGroupRequest) (string, error)
+	PlacementGroupDelete(ctx context.Context, id string) error
+	PlacementGroupGetByName(ctx context.Context, id, name string) (*compute.PlacementGroup, error)
 }
 
 // ApplicationLoadBalancer defines interface for YandexCloud ALB operations.
@@ -40,12 +64,45 @@ type NetworkLoadBalancer interface {
 	NLBAddTarget(ctx context.Context, req *nlb.AddTargetsRequest) (*operation.Operation, error)
 	NLBGetTargetGroup(ctx context.Context, targetGroupID string) (*nlb.TargetGroup, error)
 	NLBRemoveTarget(ctx context.Context, req *nlb.RemoveTargetsRequest) (*operation.Operation, error)
+	NLBTargetGroupCreate(ctx context.Context, req *nlb.CreateTargetGroupRequest) (string, error)
+	NLBTargetGroupDelete(ctx context.Context, id string) error
+	NLBTargetGroupGetByName(ctx context.Context, id, name string) (*nlb.TargetGroup, error)
+	NLBCreate(ctx context.Context, req *nlb.CreateNetworkLoadBalancerRequest) (string, error)
+	NLBDelete(ctx context.Context, id string) error
+	NLBGet(ctx context.Context, id string) (*nlb.NetworkLoadBalancer, error)
+	NLBGetByName(ctx context.Context, id, name string) (*nlb.NetworkLoadBalancer, error)
+}
+
+// VPC defines interface for YandexCloud VPC operations.
+type VPC interface {
+	VPCSecurityGroupCreate(ctx context.Context, req *vpc.CreateSecurityGroupRequest) (string, error)
+	VPCSecurityGroupUpdate(ctx context.Context, req *vpc.UpdateSecurityGroupRequest) error
+	VPCSecurityGroupDelete(ctx context.Context, id string) error
+	VPCSecurityGroupGet(ctx context.Context, id string) (*vpc.SecurityGroup, error)
+	VPCSecurityGroupGetByName(ctx context.Context, id, name string) (*vpc.SecurityGroup, error)
+	VPCNetworkCreate(ctx context.Context, req *vpc.CreateNetworkRequest) (string, error)
+	VPCNetworkDelete(ctx context.Context, id string) error
+	VPCNetworkGetByName(ctx context.Context, id, name string) (*vpc.Network, error)
+	VPCSubnetCreate(ctx context.Context, req *vpc.CreateSubnetRequest) (string, error)
+	VPCSubnetDelete(ctx context.Context, id string) error
+	VPCSubnetGetByName(ctx context.Context, id, name string) (*vpc.Subnet, error)
+	VPCSubnetUpdate(ctx context.Context, req *vpc.UpdateSubnetRequest) error
+	VPCGatewayCreate(ctx context.Context, req *vpc.CreateGatewayRequest) (string, error)
+	VPCGatewayDelete(ctx context.Context, id string) error
+	VPCGatewayGetByName(ctx context.Context, id, name string) (*vpc.Gateway, error)
+	VPCRouteTableCreate(ctx context.Context, req *vpc.CreateRouteTableRequest) (string, error)
+	VPCRouteTableDelete(ctx context.Context, id string) error
+	VPCRouteTableGetByName(ctx context.Context, id, name string) (*vpc.RouteTable, error)
+	VPCAddressGet(ctx context.Context, id string) (*vpc.Address, error)
 }
 
 // Client defines interface for YandexCloud API.
 type Client interface {
 	Compute
+	InstanceGroup
+	PlacementGroup
 	ApplicationLoadBalancer
 	NetworkLoadBalancer
+	VPC
 	Close(ctx context.Context) error
 }
@@ -15,8 +15,10 @@ import (
 
 	apploadbalancer "github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	compute "github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	instancegroup "github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
 	loadbalancer "github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
 	operation "github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	vpc "github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -323,6 +325,165 @@ func (mr *MockClientMockRecorder) ComputeGet(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeGet", reflect.TypeOf((*MockClient)(nil).ComputeGet), arg0, arg1)
 }
 
+// ComputeStart mocks base method.
+func (m *MockClient) ComputeStart(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeStart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComputeStart indicates an expected call of ComputeStart.
+func (mr *MockClientMockRecorder) ComputeStart(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeStart", reflect.TypeOf((*MockClient)(nil).ComputeStart), arg0, arg1)
+}
+
+// ComputeStop mocks base method.
+func (m *MockClient) ComputeStop(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeStop", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComputeStop indicates an expected call of ComputeStop.
+func (mr *MockClientMockRecorder) ComputeStop(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeStop", reflect.TypeOf((*MockClient)(nil).ComputeStop), arg0, arg1)
+}
+
+// ComputeUpdate mocks base method.
+func (m *MockClient) ComputeUpdate(arg0 context.Context, arg1 *compute.UpdateInstanceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeUpdate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComputeUpdate indicates an expected call of ComputeUpdate.
+func (mr *MockClientMockRecorder) ComputeUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeUpdate", reflect.TypeOf((*MockClient)(nil).ComputeUpdate), arg0, arg1)
+}
+
+// ComputeUpdateMetadata mocks base method.
+func (m *MockClient) ComputeUpdateMetadata(arg0 context.Context, arg1 string, arg2 map[string]string, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeUpdateMetadata", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ComputeUpdateMetadata indicates an expected call of ComputeUpdateMetadata.
+func (mr *MockClientMockRecorder) ComputeUpdateMetadata(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeUpdateMetadata", reflect.TypeOf((*MockClient)(nil).ComputeUpdateMetadata), arg0, arg1, arg2, arg3)
+}
+
+// DiskGet mocks base method.
+func (m *MockClient) DiskGet(arg0 context.Context, arg1 string) (*compute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiskGet", arg0, arg1)
+	ret0, _ := ret[0].(*compute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiskGet indicates an expected call of DiskGet.
+func (mr *MockClientMockRecorder) DiskGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiskGet", reflect.TypeOf((*MockClient)(nil).DiskGet), arg0, arg1)
+}
+
+// ImageGetLatestByFamily mocks base method.
+func (m *MockClient) ImageGetLatestByFamily(arg0 context.Context, arg1, arg2 string) (*compute.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageGetLatestByFamily", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageGetLatestByFamily indicates an expected call of ImageGetLatestByFamily.
+func (mr *MockClientMockRecorder) ImageGetLatestByFamily(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageGetLatestByFamily", reflect.TypeOf((*MockClient)(nil).ImageGetLatestByFamily), arg0, arg1, arg2)
+}
+
+// InstanceGroupCreate mocks base method.
+func (m *MockClient) InstanceGroupCreate(arg0 context.Context, arg1 *instancegroup.CreateInstanceGroupRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceGroupCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceGroupCreate indicates an expected call of InstanceGroupCreate.
+func (mr *MockClientMockRecorder) InstanceGroupCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceGroupCreate", reflect.TypeOf((*MockClient)(nil).InstanceGroupCreate), arg0, arg1)
+}
+
+// InstanceGroupDelete mocks base method.
+func (m *MockClient) InstanceGroupDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceGroupDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstanceGroupDelete indicates an expected call of InstanceGroupDelete.
+func (mr *MockClientMockRecorder) InstanceGroupDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceGroupDelete", reflect.TypeOf((*MockClient)(nil).InstanceGroupDelete), arg0, arg1)
+}
+
+// InstanceGroupGetByName mocks base method.
+func (m *MockClient) InstanceGroupGetByName(arg0 context.Context, arg1, arg2 string) (*instancegroup.InstanceGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceGroupGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*instancegroup.InstanceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceGroupGetByName indicates an expected call of InstanceGroupGetByName.
+func (mr *MockClientMockRecorder) InstanceGroupGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceGroupGetByName", reflect.TypeOf((*MockClient)(nil).InstanceGroupGetByName), arg0, arg1, arg2)
+}
+
+// InstanceGroupListInstances mocks base method.
+func (m *MockClient) InstanceGroupListInstances(arg0 context.Context, arg1 string) ([]*instancegroup.ManagedInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceGroupListInstances", arg0, arg1)
+	ret0, _ := ret[0].([]*instancegroup.ManagedInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceGroupListInstances indicates an expected call of InstanceGroupListInstances.
+func (mr *MockClientMockRecorder) InstanceGroupListInstances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceGroupListInstances", reflect.TypeOf((*MockClient)(nil).InstanceGroupListInstances), arg0, arg1)
+}
+
+// InstanceGroupUpdate mocks base method.
+func (m *MockClient) InstanceGroupUpdate(arg0 context.Context, arg1 *instancegroup.UpdateInstanceGroupRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceGroupUpdate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstanceGroupUpdate indicates an expected call of InstanceGroupUpdate.
+func (mr *MockClientMockRecorder) InstanceGroupUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceGroupUpdate", reflect.TypeOf((*MockClient)(nil).InstanceGroupUpdate), arg0, arg1)
+}
+
 // NLBAddTarget mocks base method.
 func (m *MockClient) NLBAddTarget(arg0 context.Context, arg1 *loadbalancer.AddTargetsRequest) (*operation.Operation, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +499,65 @@ func (mr *MockClientMockRecorder) NLBAddTarget(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBAddTarget", reflect.TypeOf((*MockClient)(nil).NLBAddTarget), arg0, arg1)
 }
 
+// NLBCreate mocks base method.
+func (m *MockClient) NLBCreate(arg0 context.Context, arg1 *loadbalancer.CreateNetworkLoadBalancerRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NLBCreate indicates an expected call of NLBCreate.
+func (mr *MockClientMockRecorder) NLBCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBCreate", reflect.TypeOf((*MockClient)(nil).NLBCreate), arg0, arg1)
+}
+
+// NLBDelete mocks base method.
+func (m *MockClient) NLBDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NLBDelete indicates an expected call of NLBDelete.
+func (mr *MockClientMockRecorder) NLBDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBDelete", reflect.TypeOf((*MockClient)(nil).NLBDelete), arg0, arg1)
+}
+
+// NLBGet mocks base method.
+func (m *MockClient) NLBGet(arg0 context.Context, arg1 string) (*loadbalancer.NetworkLoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBGet", arg0, arg1)
+	ret0, _ := ret[0].(*loadbalancer.NetworkLoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NLBGet indicates an expected call of NLBGet.
+func (mr *MockClientMockRecorder) NLBGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBGet", reflect.TypeOf((*MockClient)(nil).NLBGet), arg0, arg1)
+}
+
+// NLBGetByName mocks base method.
+func (m *MockClient) NLBGetByName(arg0 context.Context, arg1, arg2 string) (*loadbalancer.NetworkLoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*loadbalancer.NetworkLoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NLBGetByName indicates an expected call of NLBGetByName.
+func (mr *MockClientMockRecorder) NLBGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBGetByName", reflect.TypeOf((*MockClient)(nil).NLBGetByName), arg0, arg1, arg2)
+}
+
 // NLBGetTargetGroup mocks base method.
 func (m *MockClient) NLBGetTargetGroup(arg0 context.Context, arg1 string) (*loadbalancer.TargetGroup, error) {
 	m.ctrl.T.Helper()
@@ -367,3 +587,369 @@ func (mr *MockClientMockRecorder) NLBRemoveTarget(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBRemoveTarget", reflect.TypeOf((*MockClient)(nil).NLBRemoveTarget), arg0, arg1)
 }
+
+// NLBTargetGroupCreate mocks base method.
+func (m *MockClient) NLBTargetGroupCreate(arg0 context.Context, arg1 *loadbalancer.CreateTargetGroupRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBTargetGroupCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NLBTargetGroupCreate indicates an expected call of NLBTargetGroupCreate.
+func (mr *MockClientMockRecorder) NLBTargetGroupCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBTargetGroupCreate", reflect.TypeOf((*MockClient)(nil).NLBTargetGroupCreate), arg0, arg1)
+}
+
+// NLBTargetGroupDelete mocks base method.
+func (m *MockClient) NLBTargetGroupDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBTargetGroupDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NLBTargetGroupDelete indicates an expected call of NLBTargetGroupDelete.
+func (mr *MockClientMockRecorder) NLBTargetGroupDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBTargetGroupDelete", reflect.TypeOf((*MockClient)(nil).NLBTargetGroupDelete), arg0, arg1)
+}
+
+// NLBTargetGroupGetByName mocks base method.
+func (m *MockClient) NLBTargetGroupGetByName(arg0 context.Context, arg1, arg2 string) (*loadbalancer.TargetGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NLBTargetGroupGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*loadbalancer.TargetGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NLBTargetGroupGetByName indicates an expected call of NLBTargetGroupGetByName.
+func (mr *MockClientMockRecorder) NLBTargetGroupGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NLBTargetGroupGetByName", reflect.TypeOf((*MockClient)(nil).NLBTargetGroupGetByName), arg0, arg1, arg2)
+}
+
+// PlacementGroupCreate mocks base method.
+func (m *MockClient) PlacementGroupCreate(arg0 context.Context, arg1 *compute.CreatePlacementGroupRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlacementGroupCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlacementGroupCreate indicates an expected call of PlacementGroupCreate.
+func (mr *MockClientMockRecorder) PlacementGroupCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacementGroupCreate", reflect.TypeOf((*MockClient)(nil).PlacementGroupCreate), arg0, arg1)
+}
+
+// PlacementGroupDelete mocks base method.
+func (m *MockClient) PlacementGroupDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlacementGroupDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlacementGroupDelete indicates an expected call of PlacementGroupDelete.
+func (mr *MockClientMockRecorder) PlacementGroupDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacementGroupDelete", reflect.TypeOf((*MockClient)(nil).PlacementGroupDelete), arg0, arg1)
+}
+
+// PlacementGroupGetByName mocks base method.
+func (m *MockClient) PlacementGroupGetByName(arg0 context.Context, arg1, arg2 string) (*compute.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlacementGroupGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlacementGroupGetByName indicates an expected call of PlacementGroupGetByName.
+func (mr *MockClientMockRecorder) PlacementGroupGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlacementGroupGetByName", reflect.TypeOf((*MockClient)(nil).PlacementGroupGetByName), arg0, arg1, arg2)
+}
+
+// VPCAddressGet mocks base method.
+func (m *MockClient) VPCAddressGet(arg0 context.Context, arg1 string) (*vpc.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCAddressGet", arg0, arg1)
+	ret0, _ := ret[0].(*vpc.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCAddressGet indicates an expected call of VPCAddressGet.
+func (mr *MockClientMockRecorder) VPCAddressGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCAddressGet", reflect.TypeOf((*MockClient)(nil).VPCAddressGet), arg0, arg1)
+}
+
+// VPCGatewayCreate mocks base method.
+func (m *MockClient) VPCGatewayCreate(arg0 context.Context, arg1 *vpc.CreateGatewayRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCGatewayCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCGatewayCreate indicates an expected call of VPCGatewayCreate.
+func (mr *MockClientMockRecorder) VPCGatewayCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCGatewayCreate", reflect.TypeOf((*MockClient)(nil).VPCGatewayCreate), arg0, arg1)
+}
+
+// VPCGatewayDelete mocks base method.
+func (m *MockClient) VPCGatewayDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCGatewayDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCGatewayDelete indicates an expected call of VPCGatewayDelete.
+func (mr *MockClientMockRecorder) VPCGatewayDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCGatewayDelete", reflect.TypeOf((*MockClient)(nil).VPCGatewayDelete), arg0, arg1)
+}
+
+// VPCGatewayGetByName mocks base method.
+func (m *MockClient) VPCGatewayGetByName(arg0 context.Context, arg1, arg2 string) (*vpc.Gateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCGatewayGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*vpc.Gateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCGatewayGetByName indicates an expected call of VPCGatewayGetByName.
+func (mr *MockClientMockRecorder) VPCGatewayGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCGatewayGetByName", reflect.TypeOf((*MockClient)(nil).VPCGatewayGetByName), arg0, arg1, arg2)
+}
+
+// VPCNetworkCreate mocks base method.
+func (m *MockClient) VPCNetworkCreate(arg0 context.Context, arg1 *vpc.CreateNetworkRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCNetworkCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCNetworkCreate indicates an expected call of VPCNetworkCreate.
+func (mr *MockClientMockRecorder) VPCNetworkCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCNetworkCreate", reflect.TypeOf((*MockClient)(nil).VPCNetworkCreate), arg0, arg1)
+}
+
+// VPCNetworkDelete mocks base method.
+func (m *MockClient) VPCNetworkDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCNetworkDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCNetworkDelete indicates an expected call of VPCNetworkDelete.
+func (mr *MockClientMockRecorder) VPCNetworkDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCNetworkDelete", reflect.TypeOf((*MockClient)(nil).VPCNetworkDelete), arg0, arg1)
+}
+
+// VPCNetworkGetByName mocks base method.
+func (m *MockClient) VPCNetworkGetByName(arg0 context.Context, arg1, arg2 string) (*vpc.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCNetworkGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*vpc.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCNetworkGetByName indicates an expected call of VPCNetworkGetByName.
+func (mr *MockClientMockRecorder) VPCNetworkGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCNetworkGetByName", reflect.TypeOf((*MockClient)(nil).VPCNetworkGetByName), arg0, arg1, arg2)
+}
+
+// VPCRouteTableCreate mocks base method.
+func (m *MockClient) VPCRouteTableCreate(arg0 context.Context, arg1 *vpc.CreateRouteTableRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCRouteTableCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCRouteTableCreate indicates an expected call of VPCRouteTableCreate.
+func (mr *MockClientMockRecorder) VPCRouteTableCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCRouteTableCreate", reflect.TypeOf((*MockClient)(nil).VPCRouteTableCreate), arg0, arg1)
+}
+
+// VPCRouteTableDelete mocks base method.
+func (m *MockClient) VPCRouteTableDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCRouteTableDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCRouteTableDelete indicates an expected call of VPCRouteTableDelete.
+func (mr *MockClientMockRecorder) VPCRouteTableDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCRouteTableDelete", reflect.TypeOf((*MockClient)(nil).VPCRouteTableDelete), arg0, arg1)
+}
+
+// VPCRouteTableGetByName mocks base method.
+func (m *MockClient) VPCRouteTableGetByName(arg0 context.Context, arg1, arg2 string) (*vpc.RouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCRouteTableGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*vpc.RouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCRouteTableGetByName indicates an expected call of VPCRouteTableGetByName.
+func (mr *MockClientMockRecorder) VPCRouteTableGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCRouteTableGetByName", reflect.TypeOf((*MockClient)(nil).VPCRouteTableGetByName), arg0, arg1, arg2)
+}
+
+// VPCSecurityGroupCreate mocks base method.
+func (m *MockClient) VPCSecurityGroupCreate(arg0 context.Context, arg1 *vpc.CreateSecurityGroupRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSecurityGroupCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCSecurityGroupCreate indicates an expected call of VPCSecurityGroupCreate.
+func (mr *MockClientMockRecorder) VPCSecurityGroupCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSecurityGroupCreate", reflect.TypeOf((*MockClient)(nil).VPCSecurityGroupCreate), arg0, arg1)
+}
+
+// VPCSecurityGroupDelete mocks base method.
+func (m *MockClient) VPCSecurityGroupDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSecurityGroupDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCSecurityGroupDelete indicates an expected call of VPCSecurityGroupDelete.
+func (mr *MockClientMockRecorder) VPCSecurityGroupDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSecurityGroupDelete", reflect.TypeOf((*MockClient)(nil).VPCSecurityGroupDelete), arg0, arg1)
+}
+
+// VPCSecurityGroupGet mocks base method.
+func (m *MockClient) VPCSecurityGroupGet(arg0 context.Context, arg1 string) (*vpc.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSecurityGroupGet", arg0, arg1)
+	ret0, _ := ret[0].(*vpc.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCSecurityGroupGet indicates an expected call of VPCSecurityGroupGet.
+func (mr *MockClientMockRecorder) VPCSecurityGroupGet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSecurityGroupGet", reflect.TypeOf((*MockClient)(nil).VPCSecurityGroupGet), arg0, arg1)
+}
+
+// VPCSecurityGroupGetByName mocks base method.
+func (m *MockClient) VPCSecurityGroupGetByName(arg0 context.Context, arg1, arg2 string) (*vpc.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSecurityGroupGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*vpc.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCSecurityGroupGetByName indicates an expected call of VPCSecurityGroupGetByName.
+func (mr *MockClientMockRecorder) VPCSecurityGroupGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSecurityGroupGetByName", reflect.TypeOf((*MockClient)(nil).VPCSecurityGroupGetByName), arg0, arg1, arg2)
+}
+
+// VPCSecurityGroupUpdate mocks base method.
+func (m *MockClient) VPCSecurityGroupUpdate(arg0 context.Context, arg1 *vpc.UpdateSecurityGroupRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSecurityGroupUpdate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCSecurityGroupUpdate indicates an expected call of VPCSecurityGroupUpdate.
+func (mr *MockClientMockRecorder) VPCSecurityGroupUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSecurityGroupUpdate", reflect.TypeOf((*MockClient)(nil).VPCSecurityGroupUpdate), arg0, arg1)
+}
+
+// VPCSubnetCreate mocks base method.
+func (m *MockClient) VPCSubnetCreate(arg0 context.Context, arg1 *vpc.CreateSubnetRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSubnetCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCSubnetCreate indicates an expected call of VPCSubnetCreate.
+func (mr *MockClientMockRecorder) VPCSubnetCreate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSubnetCreate", reflect.TypeOf((*MockClient)(nil).VPCSubnetCreate), arg0, arg1)
+}
+
+// VPCSubnetDelete mocks base method.
+func (m *MockClient) VPCSubnetDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSubnetDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCSubnetDelete indicates an expected call of VPCSubnetDelete.
+func (mr *MockClientMockRecorder) VPCSubnetDelete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSubnetDelete", reflect.TypeOf((*MockClient)(nil).VPCSubnetDelete), arg0, arg1)
+}
+
+// VPCSubnetGetByName mocks base method.
+func (m *MockClient) VPCSubnetGetByName(arg0 context.Context, arg1, arg2 string) (*vpc.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSubnetGetByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*vpc.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCSubnetGetByName indicates an expected call of VPCSubnetGetByName.
+func (mr *MockClientMockRecorder) VPCSubnetGetByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSubnetGetByName", reflect.TypeOf((*MockClient)(nil).VPCSubnetGetByName), arg0, arg1, arg2)
+}
+
+// VPCSubnetUpdate mocks base method.
+func (m *MockClient) VPCSubnetUpdate(arg0 context.Context, arg1 *vpc.UpdateSubnetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCSubnetUpdate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VPCSubnetUpdate indicates an expected call of VPCSubnetUpdate.
+func (mr *MockClientMockRecorder) VPCSubnetUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCSubnetUpdate", reflect.TypeOf((*MockClient)(nil).VPCSubnetUpdate), arg0, arg1)
+}
@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/metrics"
 	nlb "github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
 )
 
 // NLBAddTarget sends AddTargetsRequest to Yandex NLB TargetGroup.
@@ -34,3 +36,132 @@ func (c *YandexClient) NLBGetTargetGroup(ctx context.Context, targetGroupID stri
 	mc.ObserveRequest(err)
 	return result, err
 }
+
+// NLBTargetGroupCreate sends NLB TargetGroup creation request to Yandex Cloud and returns TargetGroup Instance ID.
+func (c *YandexClient) NLBTargetGroupCreate(ctx context.Context, req *nlb.CreateTargetGroupRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlbTargetGroup)
+	op, err := c.sdk.LoadBalancer().TargetGroup().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	meta, err := c.getMeta(op)
+	if err != nil {
+		return "", err
+	}
+
+	tgmeta, ok := meta.(*nlb.CreateTargetGroupMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get network loadbalancer TargetGroup metatdata from operation response")
+	}
+
+	return tgmeta.GetTargetGroupId(), nil
+}
+
+// NLBTargetGroupDelete sends NLB TargetGroup deletion request to Yandex Cloud and returns operation result.
+func (c *YandexClient) NLBTargetGroupDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlbTargetGroup)
+	request := &nlb.DeleteTargetGroupRequest{
+		TargetGroupId: id,
+	}
+
+	_, err := c.sdk.LoadBalancer().TargetGroup().Delete(ctx, request)
+	mc.ObserveRequest(err)
+	return err
+}
+
+// NLBTargetGroupGetByName returns NLB TargetGroup instance by name for the specified Folder ID.
+func (c *YandexClient) NLBTargetGroupGetByName(ctx context.Context, id, name string) (*nlb.TargetGroup, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlbTargetGroup)
+	resp, err := c.sdk.LoadBalancer().TargetGroup().List(ctx, &nlb.ListTargetGroupsRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.TargetGroups) == 0 {
+		return nil, nil
+	}
+	return resp.TargetGroups[0], nil
+}
+
+// NLBCreate sends NLB creation request to Yandex Cloud and returns NLB ID.
+func (c *YandexClient) NLBCreate(ctx context.Context, req *nlb.CreateNetworkLoadBalancerRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlb)
+	op, err := c.sdk.LoadBalancer().NetworkLoadBalancer().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	ci, err := c.sdk.WrapOperation(op, err)
+	if err != nil {
+		return "", err
+	}
+
+	meta, err := ci.Metadata()
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*nlb.CreateNetworkLoadBalancerMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get network load balancer ID from create operation metadata")
+	}
+
+	// We have to wait until NLB will be created and operational.
+	id := md.GetNetworkLoadBalancerId()
+	if err := ci.Wait(ctx); err != nil {
+		return "", err
+	}
+
+	if _, err := ci.Response(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// NLBDelete sends NLB deletion request to Yandex Cloud and returns operation result.
+func (c *YandexClient) NLBDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlb)
+	request := &nlb.DeleteNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: id,
+	}
+
+	_, err := c.sdk.LoadBalancer().NetworkLoadBalancer().Delete(ctx, request)
+	mc.ObserveRequest(err)
+	return err
+}
+
+// NLBGet returns NLB instance by instance ID.
+func (c *YandexClient) NLBGet(ctx context.Context, id string) (*nlb.NetworkLoadBalancer, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlb)
+	result, err := c.sdk.LoadBalancer().NetworkLoadBalancer().Get(ctx, &nlb.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: id,
+	})
+	mc.ObserveRequest(err)
+	return result, err
+}
+
+// NLBGetByName returns NLB instance by name for the specified Folder ID.
+func (c *YandexClient) NLBGetByName(ctx context.Context, id, name string) (*nlb.NetworkLoadBalancer, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelNlb)
+	resp, err := c.sdk.LoadBalancer().NetworkLoadBalancer().List(ctx, &nlb.ListNetworkLoadBalancersRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.NetworkLoadBalancers) == 0 {
+		return nil, nil
+	}
+	return resp.NetworkLoadBalancers[0], nil
+}
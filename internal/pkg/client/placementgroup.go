@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/metrics"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+)
+
+// PlacementGroupCreate sends PlacementGroup creation request to Yandex Cloud and returns PlacementGroup ID.
+func (c *YandexClient) PlacementGroupCreate(ctx context.Context, req *compute.CreatePlacementGroupRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelPlacementGroup)
+	op, err := c.sdk.Compute().PlacementGroup().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	// We have to wait until the placement group will be created, before the instances could be placed in it.
+	meta, err := c.getMetaAndWait(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*compute.CreatePlacementGroupMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get placement group ID from create operation metadata")
+	}
+
+	return md.GetPlacementGroupId(), nil
+}
+
+// PlacementGroupDelete sends PlacementGroup deletion request to Yandex Cloud and waits until the group is deleted.
+func (c *YandexClient) PlacementGroupDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelPlacementGroup)
+	op, err := c.sdk.Compute().PlacementGroup().Delete(ctx, &compute.DeletePlacementGroupRequest{
+		PlacementGroupId: id,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// PlacementGroupGetByName returns PlacementGroup by name for the specified Folder ID.
+func (c *YandexClient) PlacementGroupGetByName(ctx context.Context, id, name string) (*compute.PlacementGroup, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelPlacementGroup)
+	resp, err := c.sdk.Compute().PlacementGroup().List(ctx, &compute.ListPlacementGroupsRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.PlacementGroups) == 0 {
+		return nil, nil
+	}
+	return resp.PlacementGroups[0], nil
+}
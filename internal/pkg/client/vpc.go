@@ -0,0 +1,356 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/metrics"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// VPCSecurityGroupCreate sends SecurityGroup creation request to Yandex Cloud and returns SecurityGroup ID.
+func (c *YandexClient) VPCSecurityGroupCreate(ctx context.Context, req *vpc.CreateSecurityGroupRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSecurityGroup)
+	op, err := c.sdk.VPC().SecurityGroup().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	// We have to wait until the security group will be created, before it could be attached to other resources.
+	meta, err := c.getMetaAndWait(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*vpc.CreateSecurityGroupMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get security group ID from create operation metadata")
+	}
+
+	return md.GetSecurityGroupId(), nil
+}
+
+// VPCSecurityGroupUpdate sends SecurityGroup update request to Yandex Cloud.
+func (c *YandexClient) VPCSecurityGroupUpdate(ctx context.Context, req *vpc.UpdateSecurityGroupRequest) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSecurityGroup)
+	op, err := c.sdk.VPC().SecurityGroup().Update(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// VPCSecurityGroupDelete sends SecurityGroup deletion request to Yandex Cloud.
+func (c *YandexClient) VPCSecurityGroupDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSecurityGroup)
+	_, err := c.sdk.VPC().SecurityGroup().Delete(ctx, &vpc.DeleteSecurityGroupRequest{
+		SecurityGroupId: id,
+	})
+	mc.ObserveRequest(err)
+	return err
+}
+
+// VPCSecurityGroupGet returns SecurityGroup by ID.
+func (c *YandexClient) VPCSecurityGroupGet(ctx context.Context, id string) (*vpc.SecurityGroup, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSecurityGroup)
+	result, err := c.sdk.VPC().SecurityGroup().Get(ctx, &vpc.GetSecurityGroupRequest{
+		SecurityGroupId: id,
+	})
+	mc.ObserveRequest(err)
+	return result, err
+}
+
+// VPCSecurityGroupGetByName returns SecurityGroup by name for the specified Folder ID.
+func (c *YandexClient) VPCSecurityGroupGetByName(ctx context.Context, id, name string) (*vpc.SecurityGroup, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSecurityGroup)
+	resp, err := c.sdk.VPC().SecurityGroup().List(ctx, &vpc.ListSecurityGroupsRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.SecurityGroups) == 0 {
+		return nil, nil
+	}
+	return resp.SecurityGroups[0], nil
+}
+
+// VPCNetworkCreate sends Network creation request to Yandex Cloud and returns Network ID.
+func (c *YandexClient) VPCNetworkCreate(ctx context.Context, req *vpc.CreateNetworkRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCNetwork)
+	op, err := c.sdk.VPC().Network().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	// We have to wait until the network will be created, before subnets could be created in it.
+	meta, err := c.getMetaAndWait(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*vpc.CreateNetworkMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get network ID from create operation metadata")
+	}
+
+	return md.GetNetworkId(), nil
+}
+
+// VPCNetworkDelete sends Network deletion request to Yandex Cloud and waits until the network is deleted.
+func (c *YandexClient) VPCNetworkDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCNetwork)
+	op, err := c.sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{
+		NetworkId: id,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// VPCNetworkGetByName returns Network by name for the specified Folder ID.
+func (c *YandexClient) VPCNetworkGetByName(ctx context.Context, id, name string) (*vpc.Network, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCNetwork)
+	resp, err := c.sdk.VPC().Network().List(ctx, &vpc.ListNetworksRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Networks) == 0 {
+		return nil, nil
+	}
+	return resp.Networks[0], nil
+}
+
+// VPCSubnetCreate sends Subnet creation request to Yandex Cloud and returns Subnet ID.
+func (c *YandexClient) VPCSubnetCreate(ctx context.Context, req *vpc.CreateSubnetRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSubnet)
+	op, err := c.sdk.VPC().Subnet().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	// We have to wait until the subnet will be created, before it could be used by other resources.
+	meta, err := c.getMetaAndWait(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*vpc.CreateSubnetMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get subnet ID from create operation metadata")
+	}
+
+	return md.GetSubnetId(), nil
+}
+
+// VPCSubnetDelete sends Subnet deletion request to Yandex Cloud and waits until the subnet is deleted.
+func (c *YandexClient) VPCSubnetDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSubnet)
+	op, err := c.sdk.VPC().Subnet().Delete(ctx, &vpc.DeleteSubnetRequest{
+		SubnetId: id,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// VPCSubnetGetByName returns Subnet by name for the specified Folder ID.
+func (c *YandexClient) VPCSubnetGetByName(ctx context.Context, id, name string) (*vpc.Subnet, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSubnet)
+	resp, err := c.sdk.VPC().Subnet().List(ctx, &vpc.ListSubnetsRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Subnets) == 0 {
+		return nil, nil
+	}
+	return resp.Subnets[0], nil
+}
+
+// VPCSubnetUpdate sends Subnet update request to Yandex Cloud and waits until the subnet is updated.
+func (c *YandexClient) VPCSubnetUpdate(ctx context.Context, req *vpc.UpdateSubnetRequest) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCSubnet)
+	op, err := c.sdk.VPC().Subnet().Update(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// VPCGatewayCreate sends Gateway creation request to Yandex Cloud and returns Gateway ID.
+func (c *YandexClient) VPCGatewayCreate(ctx context.Context, req *vpc.CreateGatewayRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCGateway)
+	op, err := c.sdk.VPC().Gateway().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	// We have to wait until the gateway will be created, before it could be used in a route table.
+	meta, err := c.getMetaAndWait(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*vpc.CreateGatewayMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get gateway ID from create operation metadata")
+	}
+
+	return md.GetGatewayId(), nil
+}
+
+// VPCGatewayDelete sends Gateway deletion request to Yandex Cloud and waits until the gateway is deleted.
+func (c *YandexClient) VPCGatewayDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCGateway)
+	op, err := c.sdk.VPC().Gateway().Delete(ctx, &vpc.DeleteGatewayRequest{
+		GatewayId: id,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// VPCGatewayGetByName returns Gateway by name for the specified Folder ID.
+func (c *YandexClient) VPCGatewayGetByName(ctx context.Context, id, name string) (*vpc.Gateway, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCGateway)
+	resp, err := c.sdk.VPC().Gateway().List(ctx, &vpc.ListGatewaysRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Gateways) == 0 {
+		return nil, nil
+	}
+	return resp.Gateways[0], nil
+}
+
+// VPCRouteTableCreate sends RouteTable creation request to Yandex Cloud and returns RouteTable ID.
+func (c *YandexClient) VPCRouteTableCreate(ctx context.Context, req *vpc.CreateRouteTableRequest) (string, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCRouteTable)
+	op, err := c.sdk.VPC().RouteTable().Create(ctx, req)
+	mc.ObserveRequest(err)
+	if err != nil {
+		return "", err
+	}
+
+	// We have to wait until the route table will be created, before it could be attached to subnets.
+	meta, err := c.getMetaAndWait(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	md, ok := meta.(*vpc.CreateRouteTableMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get route table ID from create operation metadata")
+	}
+
+	return md.GetRouteTableId(), nil
+}
+
+// VPCRouteTableDelete sends RouteTable deletion request to Yandex Cloud and waits until the route table is deleted.
+func (c *YandexClient) VPCRouteTableDelete(ctx context.Context, id string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCRouteTable)
+	op, err := c.sdk.VPC().RouteTable().Delete(ctx, &vpc.DeleteRouteTableRequest{
+		RouteTableId: id,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getMetaAndWait(ctx, op)
+	return err
+}
+
+// VPCRouteTableGetByName returns RouteTable by name for the specified Folder ID.
+func (c *YandexClient) VPCRouteTableGetByName(ctx context.Context, id, name string) (*vpc.RouteTable, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCRouteTable)
+	resp, err := c.sdk.VPC().RouteTable().List(ctx, &vpc.ListRouteTablesRequest{
+		FolderId: id,
+		Filter:   sdkresolvers.CreateResolverFilter("name", name),
+		PageSize: sdkresolvers.DefaultResolverPageSize,
+	})
+	mc.ObserveRequest(err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RouteTables) == 0 {
+		return nil, nil
+	}
+	return resp.RouteTables[0], nil
+}
+
+// VPCAddressGet returns Address by ID.
+func (c *YandexClient) VPCAddressGet(ctx context.Context, id string) (*vpc.Address, error) {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelVPCAddress)
+	result, err := c.sdk.VPC().Address().Get(ctx, &vpc.GetAddressRequest{
+		AddressId: id,
+	})
+	mc.ObserveRequest(err)
+	return result, err
+}
+
+// getMetaAndWait waits for the operation to complete and returns the operation metadata.
+func (c *YandexClient) getMetaAndWait(ctx context.Context, op *operation.Operation) (protoreflect.ProtoMessage, error) {
+	wo, err := c.sdk.WrapOperation(op, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := wo.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wo.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := wo.Response(); err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
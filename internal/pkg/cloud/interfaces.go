@@ -40,6 +40,9 @@ type ClusterGetter interface {
 	GetLBSpec() infrav1.LoadBalancerSpec
 	GetLBName() string
 	GetFolderID() string
+	GetFailureDomainSubnetID(zoneID string) string
+	GetControlPlanePlacementGroupID() string
+	GetNodeSecurityGroupID() string
 }
 
 // ClusterSetter is an interface which can set cluster information.
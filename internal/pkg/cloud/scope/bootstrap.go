@@ -0,0 +1,84 @@
+package scope
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// bootstrapDataKey is the bootstrap data secret key of the data.
+	bootstrapDataKey = "value"
+	// bootstrapFormatKey is the bootstrap data secret key of the data format.
+	bootstrapFormatKey = "format"
+)
+
+// getBootstrapSecretData returns the bootstrap data and its format from the bootstrap data secret.
+// The secret without the format key contains the cloud-config, as the Cluster API bootstrap providers default to it.
+func getBootstrapSecretData(secret *corev1.Secret) (string, infrav1.BootstrapFormat, error) {
+	value, ok := secret.Data[bootstrapDataKey]
+	if !ok {
+		return "", "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+
+	format := infrav1.BootstrapFormat(secret.Data[bootstrapFormatKey])
+	switch format {
+	case "":
+		format = infrav1.BootstrapFormatCloudConfig
+	case infrav1.BootstrapFormatCloudConfig, infrav1.BootstrapFormatIgnition:
+	default:
+		return "", "", fmt.Errorf("bootstrap data format %q is not supported", format)
+	}
+
+	return string(value), format, nil
+}
+
+// checkBootstrapFormat returns an error if the bootstrap data format does not match the declared one.
+func checkBootstrapFormat(declared, actual infrav1.BootstrapFormat) error {
+	if declared != "" && declared != actual {
+		return fmt.Errorf("bootstrap data format %s does not match the machine bootstrap format %s", actual, declared)
+	}
+
+	return nil
+}
+
+// getUserDataStub returns the user-data, which makes the instance download the bootstrap data from the URL.
+// The cloud-init includes the downloaded configuration, the Ignition replaces its configuration with the downloaded one.
+func getUserDataStub(data string, format infrav1.BootstrapFormat, url string) (string, error) {
+	if format != infrav1.BootstrapFormatIgnition {
+		return fmt.Sprintf("#include\n%s\n", url), nil
+	}
+
+	// The stub has the same specification version as the configuration, so the Ignition could process both of them.
+	config := struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}{}
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		return "", fmt.Errorf("failed to parse Ignition bootstrap data: %w", err)
+	}
+	if config.Ignition.Version == "" {
+		return "", errors.New("failed to parse Ignition bootstrap data: ignition version is missing")
+	}
+
+	stub := map[string]interface{}{
+		"ignition": map[string]interface{}{
+			"version": config.Ignition.Version,
+			"config": map[string]interface{}{
+				"replace": map[string]interface{}{
+					"source": url,
+				},
+			},
+		},
+	}
+	stubData, err := json.Marshal(stub)
+	if err != nil {
+		return "", err
+	}
+
+	return string(stubData), nil
+}
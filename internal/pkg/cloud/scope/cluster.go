@@ -18,6 +18,9 @@ var nonAlphanumericRegex = regexp.MustCompile(`[^a-z0-9-]+`)
 
 const (
 	maxNameLength = 64
+
+	// FailureDomainSubnetIDAttribute is the failure domain attribute with the subnet ID of the availability zone.
+	FailureDomainSubnetIDAttribute = "subnetID"
 )
 
 // ClusterScopeParams defines the input parameters used to create a new Scope.
@@ -26,6 +29,7 @@ type ClusterScopeParams struct {
 	Cluster       *clusterv1.Cluster
 	YandexCluster *infrav1.YandexCluster
 	YandexClient  yandex.Client
+	ClientCache   *yandex.ClientCache
 }
 
 // NewClusterScope creates a new Scope from the supplied parameters.
@@ -40,7 +44,16 @@ func NewClusterScope(ctx context.Context, params ClusterScopeParams) (*ClusterSc
 	if params.YandexCluster == nil {
 		return nil, errors.New("failed to generate new scope from nil YandexCluster")
 	}
-	if params.YandexClient == nil {
+
+	yandexClient := params.YandexClient
+	if params.YandexCluster.Spec.IdentityRef != nil {
+		var err error
+		yandexClient, err = getIdentityClient(ctx, params)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get YandexCloud client for the cluster identity")
+		}
+	}
+	if yandexClient == nil {
 		return nil, errors.New("failed to generate new scope from nil YandexClient")
 	}
 
@@ -54,7 +67,7 @@ func NewClusterScope(ctx context.Context, params ClusterScopeParams) (*ClusterSc
 		Cluster:       params.Cluster,
 		YandexCluster: params.YandexCluster,
 		patchHelper:   helper,
-		yandexClient:  params.YandexClient,
+		yandexClient:  yandexClient,
 	}, nil
 }
 
@@ -104,10 +117,69 @@ func (c *ClusterScope) GetFolderID() string {
 }
 
 // GetNetworkID gets the Yandex Network ID.
+// Returns the managed network ID if the network is not set in the YandexCluster specification.
 func (c *ClusterScope) GetNetworkID() string {
+	if c.YandexCluster.Spec.NetworkSpec.ID == "" {
+		return c.YandexCluster.Status.Network.ID
+	}
+
 	return c.YandexCluster.Spec.NetworkSpec.ID
 }
 
+// IsNetworkManaged returns true when the network is created and managed by the provider.
+func (c *ClusterScope) IsNetworkManaged() bool {
+	return c.YandexCluster.Spec.NetworkSpec.ID == ""
+}
+
+// GetNetworkName returns the managed network name.
+func (c *ClusterScope) GetNetworkName() string {
+	if c.YandexCluster.Spec.NetworkSpec.Name == "" {
+		return c.generateName("network")
+	}
+
+	return c.YandexCluster.Spec.NetworkSpec.Name
+}
+
+// IsNATGatewayEnabled returns true when the NAT gateway is requested for the managed network.
+func (c *ClusterScope) IsNATGatewayEnabled() bool {
+	return c.IsNetworkManaged() && c.YandexCluster.Spec.NetworkSpec.NATGateway != nil
+}
+
+// GetNATGatewayName returns the managed NAT gateway name.
+func (c *ClusterScope) GetNATGatewayName() string {
+	if gw := c.YandexCluster.Spec.NetworkSpec.NATGateway; gw != nil && gw.Name != "" {
+		return gw.Name
+	}
+
+	return c.generateName("nat")
+}
+
+// GetRouteTableName returns the managed route table name.
+func (c *ClusterScope) GetRouteTableName() string {
+	return c.generateName("route-table")
+}
+
+// GetNetworkSpec returns the network specification.
+func (c *ClusterScope) GetNetworkSpec() infrav1.NetworkSpec {
+	return c.YandexCluster.Spec.NetworkSpec
+}
+
+// SetNetworkStatus sets the managed network status.
+func (c *ClusterScope) SetNetworkStatus(status infrav1.NetworkStatus) {
+	c.YandexCluster.Status.Network = status
+}
+
+// GetManagedSubnetID returns the ID of the managed network subnet in the availability zone.
+// Returns an empty string if the subnet is not found.
+func (c *ClusterScope) GetManagedSubnetID(zoneID string) string {
+	for _, subnet := range c.YandexCluster.Status.Network.Subnets {
+		if subnet.ZoneID == zoneID {
+			return subnet.ID
+		}
+	}
+	return ""
+}
+
 // GetLabels gets the set of cluster tags.
 func (c *ClusterScope) GetLabels() infrav1.Labels {
 	return c.YandexCluster.Spec.Labels
@@ -118,34 +190,133 @@ func (c *ClusterScope) ControlPlaneEndpoint() clusterv1.APIEndpoint {
 	return c.YandexCluster.Spec.ControlPlaneEndpoint
 }
 
+// SetFailureDomains publishes the failure domains from the YandexCluster specification in the YandexCluster status.
+func (c *ClusterScope) SetFailureDomains() {
+	if len(c.YandexCluster.Spec.FailureDomains) == 0 {
+		c.YandexCluster.Status.FailureDomains = nil
+		return
+	}
+
+	failureDomains := make(clusterv1.FailureDomains, len(c.YandexCluster.Spec.FailureDomains))
+	for _, fd := range c.YandexCluster.Spec.FailureDomains {
+		failureDomains[fd.ZoneID] = clusterv1.FailureDomainSpec{
+			ControlPlane: fd.ControlPlane,
+			Attributes: map[string]string{
+				FailureDomainSubnetIDAttribute: c.GetFailureDomainSubnetID(fd.ZoneID),
+			},
+		}
+	}
+	c.YandexCluster.Status.FailureDomains = failureDomains
+}
+
+// GetFailureDomainSubnetID returns the subnet ID of the failure domain for the availability zone.
+// Falls back to the managed network subnet of the availability zone if the failure domain subnet is not set.
+// Returns an empty string if the subnet is not found.
+func (c *ClusterScope) GetFailureDomainSubnetID(zoneID string) string {
+	for _, fd := range c.YandexCluster.Spec.FailureDomains {
+		if fd.ZoneID == zoneID && fd.SubnetID != "" {
+			return fd.SubnetID
+		}
+	}
+	return c.GetManagedSubnetID(zoneID)
+}
+
+// IsControlPlanePlacementGroupManaged returns true when the control plane placement group is managed by the provider.
+func (c *ClusterScope) IsControlPlanePlacementGroupManaged() bool {
+	return c.YandexCluster.Spec.ControlPlanePlacementGroup != nil
+}
+
+// GetControlPlanePlacementGroupName returns the managed control plane placement group name.
+func (c *ClusterScope) GetControlPlanePlacementGroupName() string {
+	if pg := c.YandexCluster.Spec.ControlPlanePlacementGroup; pg != nil && pg.Name != "" {
+		return pg.Name
+	}
+
+	return c.generateName("control-plane")
+}
+
+// GetControlPlanePlacementGroupID returns the managed control plane placement group ID.
+// Returns an empty string if the placement group is not managed or not created yet.
+func (c *ClusterScope) GetControlPlanePlacementGroupID() string {
+	return c.YandexCluster.Status.ControlPlanePlacementGroupID
+}
+
+// SetControlPlanePlacementGroupID sets the managed control plane placement group ID.
+func (c *ClusterScope) SetControlPlanePlacementGroupID(id string) {
+	c.YandexCluster.Status.ControlPlanePlacementGroupID = id
+}
+
+// IsNodeSecurityGroupManaged returns true when the node security group is managed by the provider.
+func (c *ClusterScope) IsNodeSecurityGroupManaged() bool {
+	return c.YandexCluster.Spec.NodeSecurityGroup != nil
+}
+
+// GetNodeSecurityGroupName returns the managed node security group name.
+func (c *ClusterScope) GetNodeSecurityGroupName() string {
+	if sg := c.YandexCluster.Spec.NodeSecurityGroup; sg != nil && sg.Name != "" {
+		return sg.Name
+	}
+
+	return c.generateName("nodes")
+}
+
+// IsNodeSecurityGroupIPv6 returns true if the managed node security group has to allow the IPv6 traffic.
+func (c *ClusterScope) IsNodeSecurityGroupIPv6() bool {
+	sg := c.YandexCluster.Spec.NodeSecurityGroup
+	return sg != nil && (sg.IPv6 || c.YandexCluster.Spec.LoadBalancer.Listener.IPVersion == infrav1.IPVersionIPv6)
+}
+
+// GetNodeSecurityGroupID returns the managed node security group ID.
+// Returns an empty string if the security group is not managed or not created yet.
+func (c *ClusterScope) GetNodeSecurityGroupID() string {
+	return c.YandexCluster.Status.NodeSecurityGroupID
+}
+
+// SetNodeSecurityGroupID sets the managed node security group ID.
+func (c *ClusterScope) SetNodeSecurityGroupID(id string) {
+	c.YandexCluster.Status.NodeSecurityGroupID = id
+}
+
 // GetLBName returns the load balancer name.
 func (c *ClusterScope) GetLBName() string {
 	if c.YandexCluster.Spec.LoadBalancer.Name == "" {
-		return c.generateName()
+		return c.generateName("api")
 	}
 
 	return c.YandexCluster.Spec.LoadBalancer.Name
 }
 
 // GetLBSpec returns the load balancer specification.
+// Subnets which are not set explicitly are resolved to the managed network subnets of the same availability zones.
 func (c *ClusterScope) GetLBSpec() infrav1.LoadBalancerSpec {
-	return c.YandexCluster.Spec.LoadBalancer
+	lbs := *c.YandexCluster.Spec.LoadBalancer.DeepCopy()
+	if lbs.Listener.Subnet.ID == "" {
+		lbs.Listener.Subnet.ID = c.GetManagedSubnetID(lbs.Listener.Subnet.ZoneID)
+	}
+
+	for i := range lbs.Locations {
+		if lbs.Locations[i].ID == "" {
+			lbs.Locations[i].ID = c.GetManagedSubnetID(lbs.Locations[i].ZoneID)
+		}
+	}
+
+	return lbs
 }
 
 // generateName generates a resource name via:
 // 1. concatenating the cluster name to the suffix provided
 // 2. computing a hash for name, if resulting name length greater than
 // maxNameLength characters.
-func (c *ClusterScope) generateName() string {
+func (c *ClusterScope) generateName(suffix string) string {
 	prefix := c.clearString(c.Name())
-	name := fmt.Sprintf("%s-api", prefix)
+	name := fmt.Sprintf("%s-%s", prefix, suffix)
 
 	if len(name) < maxNameLength {
 		return name
 	}
 
 	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(c.Name())))
-	return fmt.Sprintf("cluster-%s-api", hash[:16])
+	return fmt.Sprintf("cluster-%s-%s", hash[:16], suffix)
 }
 
 // clearString remove all non alphnumeric characters from input.
@@ -89,3 +89,133 @@ func TestCLoudScope_GetLBName(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterScope_SetFailureDomains(t *testing.T) {
+	g := NewWithT(t)
+
+	scp := &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{
+			Spec: infrav1.YandexClusterSpec{
+				FailureDomains: []infrav1.FailureDomainSpec{
+					{ZoneID: "ru-central1-a", SubnetID: "subnet-a", ControlPlane: true},
+					{ZoneID: "ru-central1-b", SubnetID: "subnet-b"},
+				},
+			},
+		},
+	}
+
+	t.Run("SetFailureDomains should publish failure domains in the status", func(_ *testing.T) {
+		scp.SetFailureDomains()
+		g.Expect(scp.YandexCluster.Status.FailureDomains).To(Equal(v1beta1.FailureDomains{
+			"ru-central1-a": {ControlPlane: true, Attributes: map[string]string{"subnetID": "subnet-a"}},
+			"ru-central1-b": {ControlPlane: false, Attributes: map[string]string{"subnetID": "subnet-b"}},
+		}))
+	})
+
+	t.Run("GetFailureDomainSubnetID should return subnet of the zone", func(_ *testing.T) {
+		g.Expect(scp.GetFailureDomainSubnetID("ru-central1-b")).To(Equal("subnet-b"))
+		g.Expect(scp.GetFailureDomainSubnetID("ru-central1-d")).To(BeEmpty())
+	})
+}
+
+func TestClusterScope_ManagedNetwork(t *testing.T) {
+	g := NewWithT(t)
+
+	scp := &scope.ClusterScope{
+		Cluster: &v1beta1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "test-cluster"}},
+		YandexCluster: &infrav1.YandexCluster{
+			Spec: infrav1.YandexClusterSpec{
+				LoadBalancer: infrav1.LoadBalancerSpec{
+					Listener: infrav1.ListenerSpec{
+						Subnet: infrav1.SubnetSpec{ZoneID: "ru-central1-a"},
+					},
+					Locations: []infrav1.SubnetSpec{
+						{ZoneID: "ru-central1-a"},
+						{ZoneID: "ru-central1-b", ID: "subnet-explicit"},
+					},
+				},
+				FailureDomains: []infrav1.FailureDomainSpec{
+					{ZoneID: "ru-central1-a", ControlPlane: true},
+				},
+			},
+			Status: infrav1.YandexClusterStatus{
+				Network: infrav1.NetworkStatus{
+					ID: "network-managed",
+					Subnets: []infrav1.SubnetStatus{
+						{ID: "subnet-a", ZoneID: "ru-central1-a", CIDRBlock: "10.1.0.0/24"},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Managed network ID and generated name should be returned", func(_ *testing.T) {
+		g.Expect(scp.IsNetworkManaged()).To(BeTrue())
+		g.Expect(scp.GetNetworkID()).To(Equal("network-managed"))
+		g.Expect(scp.GetNetworkName()).To(Equal("test-cluster-network"))
+	})
+
+	t.Run("Load balancer subnets should be resolved from the managed subnets", func(_ *testing.T) {
+		lbs := scp.GetLBSpec()
+		g.Expect(lbs.Listener.Subnet.ID).To(Equal("subnet-a"))
+		g.Expect(lbs.Locations[0].ID).To(Equal("subnet-a"))
+		g.Expect(lbs.Locations[1].ID).To(Equal("subnet-explicit"))
+		g.Expect(scp.YandexCluster.Spec.LoadBalancer.Listener.Subnet.ID).To(BeEmpty())
+	})
+
+	t.Run("Failure domain subnet should be resolved from the managed subnets", func(_ *testing.T) {
+		g.Expect(scp.GetFailureDomainSubnetID("ru-central1-a")).To(Equal("subnet-a"))
+		scp.SetFailureDomains()
+		g.Expect(scp.YandexCluster.Status.FailureDomains["ru-central1-a"].Attributes).
+			To(HaveKeyWithValue("subnetID", "subnet-a"))
+	})
+
+	t.Run("Network ID from the specification should be preferred", func(_ *testing.T) {
+		scp.YandexCluster.Spec.NetworkSpec.ID = "network-existing"
+		g.Expect(scp.IsNetworkManaged()).To(BeFalse())
+		g.Expect(scp.GetNetworkID()).To(Equal("network-existing"))
+	})
+}
+
+func TestClusterScope_IsNodeSecurityGroupIPv6(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		testName      string
+		securityGroup *infrav1.SecurityGroupSpec
+		ipVersion     infrav1.IPVersion
+		want          bool
+	}{
+		{
+			testName:      "IPv4 cluster",
+			securityGroup: &infrav1.SecurityGroupSpec{},
+			ipVersion:     infrav1.IPVersionIPv4,
+			want:          false,
+		},
+		{
+			testName:      "IPv6 is allowed in the node security group",
+			securityGroup: &infrav1.SecurityGroupSpec{IPv6: true},
+			want:          true,
+		},
+		{
+			testName:      "IPv6 load balancer listener",
+			securityGroup: &infrav1.SecurityGroupSpec{},
+			ipVersion:     infrav1.IPVersionIPv6,
+			want:          true,
+		},
+		{
+			testName:  "node security group is not managed",
+			ipVersion: infrav1.IPVersionIPv6,
+			want:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(_ *testing.T) {
+			scp := &scope.ClusterScope{YandexCluster: &infrav1.YandexCluster{}}
+			scp.YandexCluster.Spec.NodeSecurityGroup = test.securityGroup
+			scp.YandexCluster.Spec.LoadBalancer.Listener.IPVersion = test.ipVersion
+			g.Expect(scp.IsNodeSecurityGroupIPv6()).To(Equal(test.want))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"k8s.io/utils/ptr"
+)
+
+// defaultCoreFraction is the baseline CPU performance of the instance, if it is not set in the specification.
+const defaultCoreFraction = 100
+
+// InstanceDrift describes the differences between the YandexMachine specification and the compute instance.
+type InstanceDrift struct {
+	// Immutable are the drifted fields, which are not restored by the provider.
+	Immutable []string
+	// Mutable are the drifted labels and metadata keys, which could be restored in place.
+	Mutable []string
+	// Labels are the instance labels with the drifted ones restored, nil if the labels are in sync.
+	Labels map[string]string
+	// Metadata are the drifted metadata keys with the expected values, nil if the metadata is in sync.
+	Metadata map[string]string
+}
+
+// GetInstanceDrift compares the compute instance and its boot disk with the YandexMachine specification.
+// The labels and the metadata keys set by the provider are compared only, the ones added outside of the provider
+// are not a drift. The bootstrap data is removed from the metadata after the node has joined, so it is not compared.
+func (m *MachineScope) GetInstanceDrift(
+	ctx context.Context, vm *compute.Instance, bootDisk *compute.Disk,
+) (*InstanceDrift, error) {
+	drift := &InstanceDrift{}
+	spec := m.YandexMachine.Spec
+
+	drift.addImmutable("platformID", ptr.Deref(spec.PlatformID, ""), vm.GetPlatformId())
+
+	resources := vm.GetResources()
+	drift.addImmutable("resources.cores", spec.Resources.Cores, resources.GetCores())
+	if memory, ok := spec.Resources.Memory.AsInt64(); ok {
+		drift.addImmutable("resources.memory", memory, resources.GetMemory())
+	}
+	drift.addImmutable("resources.coreFraction",
+		ptr.Deref(spec.Resources.CoreFraction, defaultCoreFraction), resources.GetCoreFraction())
+	drift.addImmutable("resources.gpus", ptr.Deref(spec.Resources.GPUs, 0), resources.GetGpus())
+
+	if bootDisk != nil && spec.BootDisk != nil {
+		drift.addImmutable("bootDisk.typeID", ptr.Deref(spec.BootDisk.TypeID, ""), bootDisk.GetTypeId())
+		if size, ok := spec.BootDisk.Size.AsInt64(); ok {
+			drift.addImmutable("bootDisk.size", size, bootDisk.GetSize())
+		}
+	}
+
+	labels := m.getMachineLabels()
+	for _, key := range sortedKeys(labels) {
+		expected := labels[key]
+		if actual, ok := vm.GetLabels()[key]; !ok || actual != expected {
+			if drift.Labels == nil {
+				drift.Labels = make(map[string]string, len(vm.GetLabels()))
+				for k, v := range vm.GetLabels() {
+					drift.Labels[k] = v
+				}
+			}
+			drift.Labels[key] = expected
+			drift.Mutable = append(drift.Mutable, fmt.Sprintf("labels.%s: expected %q, got %q", key, expected, actual))
+		}
+	}
+
+	metadata, err := getInstanceMetadata(ctx, m.client, m.Namespace(), &spec, "")
+	if err != nil {
+		return nil, err
+	}
+	delete(metadata, infrav1.MetadataKeyUserData)
+	for _, key := range sortedKeys(metadata) {
+		if actual, ok := vm.GetMetadata()[key]; !ok || actual != metadata[key] {
+			if drift.Metadata == nil {
+				drift.Metadata = make(map[string]string)
+			}
+			drift.Metadata[key] = metadata[key]
+			// The metadata values could contain secrets, so only the keys are reported.
+			drift.Mutable = append(drift.Mutable, fmt.Sprintf("metadata.%s: value differs", key))
+		}
+	}
+
+	return drift, nil
+}
+
+// IsInSync returns true if the instance matches the YandexMachine specification.
+func (d *InstanceDrift) IsInSync() bool {
+	return len(d.Immutable) == 0 && len(d.Mutable) == 0
+}
+
+// addImmutable records the drifted field, if the actual value differs from the expected one.
+func (d *InstanceDrift) addImmutable(field string, expected, actual interface{}) {
+	if expected != actual {
+		d.Immutable = append(d.Immutable, fmt.Sprintf("%s: expected %v, got %v", field, expected, actual))
+	}
+}
+
+// sortedKeys returns the map keys in the ascending order, so the drift details are stable.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
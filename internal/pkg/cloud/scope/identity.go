@@ -0,0 +1,92 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getIdentityClient returns the YandexCloud API client built from the credentials
+// of the YandexClusterIdentity referenced by the YandexCluster.
+func getIdentityClient(ctx context.Context, params ClusterScopeParams) (yandex.Client, error) {
+	if params.ClientCache == nil {
+		return nil, errors.New("client cache is required to use YandexCluster identity")
+	}
+
+	ref := params.YandexCluster.Spec.IdentityRef
+	identity := &infrav1.YandexClusterIdentity{}
+	if err := params.Client.Get(ctx, types.NamespacedName{Name: ref.Name}, identity); err != nil {
+		return nil, errors.Wrapf(err, "failed to get YandexClusterIdentity %s", ref.Name)
+	}
+
+	namespace := params.YandexCluster.Namespace
+	allowed, err := isNamespaceAllowed(ctx, params.Client, identity.Spec.AllowedNamespaces, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf("YandexClusterIdentity %s is not allowed to be used from namespace %s", ref.Name, namespace)
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: identity.Spec.SecretRef.Namespace, Name: identity.Spec.SecretRef.Name}
+	if err := params.Client.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret %s of YandexClusterIdentity %s", key, ref.Name)
+	}
+
+	value, ok := secret.Data[infrav1.IdentitySecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s of YandexClusterIdentity %s has no %q key", key, ref.Name, infrav1.IdentitySecretKey)
+	}
+
+	yandexClient, err := params.ClientCache.Get(ctx, identity.Name, string(value))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build YandexCloud client for YandexClusterIdentity %s", ref.Name)
+	}
+
+	return yandexClient, nil
+}
+
+// isNamespaceAllowed checks if the namespace is allowed to use the identity.
+func isNamespaceAllowed(ctx context.Context, c client.Client, allowed *infrav1.AllowedNamespaces, namespace string) (bool, error) {
+	if allowed == nil {
+		return false, nil
+	}
+
+	// An empty allowedNamespaces object allows all namespaces.
+	if len(allowed.NamespaceList) == 0 && allowed.Selector == nil {
+		return true, nil
+	}
+
+	if slices.Contains(allowed.NamespaceList, namespace) {
+		return true, nil
+	}
+
+	if allowed.Selector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(allowed.Selector)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to parse allowed namespaces selector")
+	}
+	if selector.Empty() {
+		return false, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, errors.Wrapf(err, "failed to get namespace %s", namespace)
+	}
+
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client/mock_client"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClusterScope_Identity(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+
+	defaultClient := mock_client.NewMockClient(gomock.NewController(t))
+	identityClient := mock_client.NewMockClient(gomock.NewController(t))
+	identityClient.EXPECT().Close(gomock.Any()).Return(nil).AnyTimes()
+
+	var builtKeys []string
+	cache := yandex.NewClientCache(func(_ context.Context, key string) (yandex.Client, error) {
+		builtKeys = append(builtKeys, key)
+		return identityClient, nil
+	})
+
+	objects := []client.Object{
+		&corev1.Namespace{ObjectMeta: v1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: v1.ObjectMeta{Name: "team-b"}},
+		&corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{Name: "identity-key", Namespace: "capy-system"},
+			Data:       map[string][]byte{infrav1.IdentitySecretKey: []byte("key-data")},
+		},
+		&infrav1.YandexClusterIdentity{
+			ObjectMeta: v1.ObjectMeta{Name: "list-identity"},
+			Spec: infrav1.YandexClusterIdentitySpec{
+				SecretRef:         infrav1.SecretReference{Name: "identity-key", Namespace: "capy-system"},
+				AllowedNamespaces: &infrav1.AllowedNamespaces{NamespaceList: []string{"team-a"}},
+			},
+		},
+		&infrav1.YandexClusterIdentity{
+			ObjectMeta: v1.ObjectMeta{Name: "selector-identity"},
+			Spec: infrav1.YandexClusterIdentitySpec{
+				SecretRef: infrav1.SecretReference{Name: "identity-key", Namespace: "capy-system"},
+				AllowedNamespaces: &infrav1.AllowedNamespaces{
+					Selector: &v1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				},
+			},
+		},
+		&infrav1.YandexClusterIdentity{
+			ObjectMeta: v1.ObjectMeta{Name: "any-identity"},
+			Spec: infrav1.YandexClusterIdentitySpec{
+				SecretRef:         infrav1.SecretReference{Name: "identity-key", Namespace: "capy-system"},
+				AllowedNamespaces: &infrav1.AllowedNamespaces{},
+			},
+		},
+		&infrav1.YandexClusterIdentity{
+			ObjectMeta: v1.ObjectMeta{Name: "none-identity"},
+			Spec: infrav1.YandexClusterIdentitySpec{
+				SecretRef: infrav1.SecretReference{Name: "identity-key", Namespace: "capy-system"},
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	newScope := func(namespace, identity string) (*scope.ClusterScope, error) {
+		yc := &infrav1.YandexCluster{ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: namespace}}
+		if identity != "" {
+			yc.Spec.IdentityRef = &infrav1.IdentityReference{Name: identity}
+		}
+		return scope.NewClusterScope(context.TODO(), scope.ClusterScopeParams{
+			Client:        k8sClient,
+			Cluster:       &v1beta1.Cluster{},
+			YandexCluster: yc,
+			YandexClient:  defaultClient,
+			ClientCache:   cache,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		namespace  string
+		identity   string
+		wantErr    bool
+		wantClient yandex.Client
+	}{
+		{name: "Cluster without identity should use the default client", namespace: "team-b", wantClient: defaultClient},
+		{name: "Namespace from the allowed list", namespace: "team-a", identity: "list-identity", wantClient: identityClient},
+		{name: "Namespace not from the allowed list", namespace: "team-b", identity: "list-identity", wantErr: true},
+		{name: "Namespace matching the selector", namespace: "team-a", identity: "selector-identity", wantClient: identityClient},
+		{name: "Namespace not matching the selector", namespace: "team-b", identity: "selector-identity", wantErr: true},
+		{name: "Empty allowed namespaces allow any namespace", namespace: "team-b", identity: "any-identity", wantClient: identityClient},
+		{name: "Nil allowed namespaces allow no namespace", namespace: "team-a", identity: "none-identity", wantErr: true},
+		{name: "Non existing identity", namespace: "team-a", identity: "missing-identity", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			scp, err := newScope(test.namespace, test.identity)
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scp.GetClient()).To(BeIdenticalTo(test.wantClient))
+		})
+	}
+
+	t.Run("Identity clients should be cached by identity name and key", func(_ *testing.T) {
+		g.Expect(builtKeys).To(Equal([]string{"key-data", "key-data", "key-data"}))
+
+		_, err := newScope("team-a", "list-identity")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(builtKeys).To(HaveLen(3))
+	})
+}
@@ -0,0 +1,123 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HasAddressesFromPools returns true if any of the YandexMachine network interfaces claims its address from IPAM pool.
+func (m *MachineScope) HasAddressesFromPools() bool {
+	for _, networkInterface := range m.YandexMachine.Spec.NetworkInterfaces {
+		if networkInterface.AddressFromPool != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReconcileIPAddressClaims creates the IPAddressClaims for the network interfaces with the IPAM pool addresses
+// and collects the allocated addresses.
+// Returns false if some of the addresses are not allocated by the IPAM provider yet.
+func (m *MachineScope) ReconcileIPAddressClaims(ctx context.Context) (bool, error) {
+	allocated := true
+	m.claimedAddresses = make(map[int]string)
+
+	for i, networkInterface := range m.YandexMachine.Spec.NetworkInterfaces {
+		if networkInterface.AddressFromPool == nil {
+			continue
+		}
+
+		claim, err := m.ensureIPAddressClaim(ctx, i, *networkInterface.AddressFromPool)
+		if err != nil {
+			return false, err
+		}
+
+		if claim.Status.AddressRef.Name == "" {
+			allocated = false
+			continue
+		}
+
+		address := &ipamv1.IPAddress{}
+		key := client.ObjectKey{Namespace: m.Namespace(), Name: claim.Status.AddressRef.Name}
+		if err := m.client.Get(ctx, key, address); err != nil {
+			return false, fmt.Errorf("failed to get IPAddress %s: %w", key, err)
+		}
+
+		if !isIPv4(address.Spec.Address) {
+			return false, fmt.Errorf("IPAddress %s has invalid IPv4 address %q", key, address.Spec.Address)
+		}
+		m.claimedAddresses[i] = address.Spec.Address
+	}
+
+	return allocated, nil
+}
+
+// ensureIPAddressClaim returns the IPAddressClaim of the network interface, the claim is created if not exists.
+// The claim is owned by the YandexMachine, so it is released when the YandexMachine is deleted.
+func (m *MachineScope) ensureIPAddressClaim(
+	ctx context.Context, index int, poolRef corev1.TypedLocalObjectReference,
+) (*ipamv1.IPAddressClaim, error) {
+	claim := &ipamv1.IPAddressClaim{}
+	key := client.ObjectKey{Namespace: m.Namespace(), Name: GetIPAddressClaimName(m.Name(), index)}
+	err := m.client.Get(ctx, key, claim)
+	if err == nil {
+		return claim, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get IPAddressClaim %s: %w", key, err)
+	}
+
+	claim = &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: m.Machine.Labels[clusterv1.ClusterNameLabel],
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: infrav1.GroupVersion.String(),
+				Kind:       "YandexMachine",
+				Name:       m.Name(),
+				UID:        m.YandexMachine.UID,
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: ipamv1.IPAddressClaimSpec{
+			PoolRef: poolRef,
+		},
+	}
+	if err := m.client.Create(ctx, claim); err != nil {
+		return nil, fmt.Errorf("failed to create IPAddressClaim %s: %w", key, err)
+	}
+
+	return claim, nil
+}
+
+// GetIPAddressClaimName returns the name of the IPAddressClaim for the YandexMachine network interface.
+func GetIPAddressClaimName(machineName string, index int) string {
+	return fmt.Sprintf("%s-%d", machineName, index)
+}
+
+// getInterfaceAddress returns the internal IPv4 address of the network interface,
+// either the static one or the one claimed from IPAM pool.
+func (m *MachineScope) getInterfaceAddress(index int, networkInterface infrav1.NetworkInterface) (string, error) {
+	if networkInterface.AddressFromPool == nil {
+		return networkInterface.Address, nil
+	}
+
+	address, ok := m.claimedAddresses[index]
+	if !ok {
+		return "", fmt.Errorf("address of the network interface %d is not allocated from IPAM pool yet", index)
+	}
+	return address, nil
+}
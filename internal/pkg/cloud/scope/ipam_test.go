@@ -0,0 +1,141 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMachineScope_ReconcileIPAddressClaims(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(ipamv1.AddToScheme(scheme)).To(Succeed())
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	bootstrapSecret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data:       map[string][]byte{"value": []byte("bootstrap-data")},
+	}
+	g.Expect(k8sClient.Create(ctx, bootstrapSecret)).To(Succeed())
+
+	poolRef := corev1.TypedLocalObjectReference{
+		APIGroup: ptr.To("ipam.cluster.x-k8s.io"),
+		Kind:     "InClusterIPPool",
+		Name:     "nodes",
+	}
+	scp, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client: k8sClient,
+		Machine: &v1beta1.Machine{
+			ObjectMeta: v1.ObjectMeta{Labels: map[string]string{v1beta1.ClusterNameLabel: "test-cluster"}},
+			Spec: v1beta1.MachineSpec{
+				Bootstrap: v1beta1.Bootstrap{DataSecretName: &bootstrapSecret.Name},
+			},
+		},
+		LoadBalancer: loadbalancer.New(&scope.ClusterScope{}),
+		ClusterGetter: &scope.ClusterScope{
+			YandexCluster: &infrav1.YandexCluster{Spec: infrav1.YandexClusterSpec{FolderID: "folder-id"}},
+		},
+		YandexMachine: &infrav1.YandexMachine{
+			ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "ym-test"},
+			Spec: infrav1.YandexMachineSpec{
+				ZoneID:     ptr.To("ru-central1-a"),
+				PlatformID: ptr.To("standard-v3"),
+				BootDisk: &infrav1.Disk{
+					TypeID:  ptr.To("network-ssd"),
+					Size:    resource.MustParse("20Gi"),
+					ImageID: "image-id",
+				},
+				Resources: infrav1.Resources{
+					Memory: resource.MustParse("4Gi"),
+					Cores:  2,
+				},
+				NetworkInterfaces: []infrav1.NetworkInterface{
+					{SubnetID: "subnet-a", Address: "10.0.0.10"},
+					{SubnetID: "subnet-b", AddressFromPool: &poolRef},
+				},
+			},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(scp.HasAddressesFromPools()).To(BeTrue())
+
+	t.Run("ReconcileIPAddressClaims should create claim and wait for allocation", func(_ *testing.T) {
+		allocated, err := scp.ReconcileIPAddressClaims(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(allocated).To(BeFalse())
+
+		claim := &ipamv1.IPAddressClaim{}
+		key := client.ObjectKey{Namespace: "test", Name: scope.GetIPAddressClaimName("ym-test", 1)}
+		g.Expect(k8sClient.Get(ctx, key, claim)).To(Succeed())
+		g.Expect(claim.Spec.PoolRef).To(Equal(poolRef))
+		g.Expect(claim.Labels).To(HaveKeyWithValue(v1beta1.ClusterNameLabel, "test-cluster"))
+		g.Expect(claim.OwnerReferences).To(HaveLen(1))
+		g.Expect(claim.OwnerReferences[0].Kind).To(Equal("YandexMachine"))
+
+		_, err = scp.GetInstanceReq()
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("ReconcileIPAddressClaims should return allocated address", func(_ *testing.T) {
+		address := &ipamv1.IPAddress{
+			ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "nodes-10-1-0-10"},
+			Spec: ipamv1.IPAddressSpec{
+				ClaimRef: corev1.LocalObjectReference{Name: scope.GetIPAddressClaimName("ym-test", 1)},
+				PoolRef:  poolRef,
+				Address:  "10.1.0.10",
+				Prefix:   24,
+			},
+		}
+		g.Expect(k8sClient.Create(ctx, address)).To(Succeed())
+
+		claim := &ipamv1.IPAddressClaim{}
+		key := client.ObjectKey{Namespace: "test", Name: scope.GetIPAddressClaimName("ym-test", 1)}
+		g.Expect(k8sClient.Get(ctx, key, claim)).To(Succeed())
+		claim.Status.AddressRef = corev1.LocalObjectReference{Name: address.Name}
+		g.Expect(k8sClient.Update(ctx, claim)).To(Succeed())
+
+		allocated, err := scp.ReconcileIPAddressClaims(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(allocated).To(BeTrue())
+
+		req, err := scp.GetInstanceReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetNetworkInterfaceSpecs()).To(HaveLen(2))
+		g.Expect(req.GetNetworkInterfaceSpecs()[0].GetPrimaryV4AddressSpec().GetAddress()).To(Equal("10.0.0.10"))
+		g.Expect(req.GetNetworkInterfaceSpecs()[1].GetPrimaryV4AddressSpec().GetAddress()).To(Equal("10.1.0.10"))
+	})
+}
@@ -24,6 +24,12 @@ const (
 	yaAnalyticsClusterHashLabel string = "yandex.cloud/capy-cluster-hash"
 	// yaAnalyticsMachineDeploymentLabel label value is the md5(folderId + clusterName + deploymentName) truncated to 20s.
 	yaAnalyticsMachineDeploymentLabel string = "yandex.cloud/capy-cluster-machine-deployment-hash"
+	// yaAnalyticsMachinePoolNameLabel representation of CAPI "cluster.x-k8s.io/pool-name" label. Absent for machines.
+	yaAnalyticsMachinePoolNameLabel string = "yandex.cloud/capy-cluster-machine-pool-name"
+	// templateHashLabel label value is the hash of the instance group template, used to detect template changes.
+	templateHashLabel string = "yandex.cloud/capy-template-hash"
+	// bootstrapHashLabel label value is the hash of the instance group bootstrap data, used to detect its refresh.
+	bootstrapHashLabel string = "yandex.cloud/capy-bootstrap-hash"
 	// managedByLabel label name identifies our controller as the VM owner.
 	managedByLabel string = "yandex.cloud/managed-by"
 	// capyControllerManagerName name of a capy controller manager deployment.
@@ -62,6 +68,21 @@ func (m *MachineScope) getMachineLabels() map[string]string {
 	return labels
 }
 
+// getMachinePoolLabels prepares labels for machine pool instance group and its instances in current scope.
+func (m *MachinePoolScope) getMachinePoolLabels() map[string]string {
+	clusterName := m.MachinePool.Spec.ClusterName
+
+	return map[string]string{
+		managedByLabel:                  capyControllerManagerName,
+		yaAnalyticsClusterNameLabel:     clusterName,
+		yaAnalyticsFolderIDLabel:        m.ClusterGetter.GetFolderID(),
+		yaAnalyticsMachinePoolNameLabel: m.MachinePool.Name,
+		yaAnalyticsClusterHashLabel:     getYaAnalyticsLabelHashValue(m.ClusterGetter.GetFolderID(), clusterName),
+		yaAnalyticsMachineDeploymentLabel: getYaAnalyticsLabelHashValue(
+			m.ClusterGetter.GetFolderID(), clusterName, m.MachinePool.Name),
+	}
+}
+
 // getYaAnalyticsLabelHashValue gets md5 from concatenated string and truncate to 20 symbols.
 func getYaAnalyticsLabelHashValue(parts ...string) string {
 	valueLength := 20
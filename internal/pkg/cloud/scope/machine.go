@@ -3,6 +3,8 @@ package scope
 import (
 	"context"
 	"fmt"
+	"net"
+	"path"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -23,6 +25,12 @@ import (
 const (
 	// ProviderIDPrefix will be appended to the beginning of Yandex Cloud resource IDs to form the Kubernetes Provider ID.
 	ProviderIDPrefix = "yandex://"
+
+	// defaultZoneID is the YandexCloud availability zone used when neither YandexMachine zone nor Machine failure domain set.
+	defaultZoneID = "ru-central1-d"
+
+	// hostGroupAffinityKey is the host affinity rule key, which places YandexCloud VM on the dedicated host group.
+	hostGroupAffinityKey = "yc.hostGroupId"
 )
 
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
@@ -68,6 +76,10 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 type MachineScope struct {
 	client      client.Client
 	patchHelper *patch.Helper
+	// claimedAddresses are the network interface addresses allocated from IPAM pools, indexed by the interface.
+	claimedAddresses map[int]string
+	// bootstrapDataURL is the object storage URL of the bootstrap data, see SetBootstrapDataURL.
+	bootstrapDataURL string
 
 	ClusterGetter cloud.ClusterGetter
 	LoadBalancer  cloud.LoadBalancer
@@ -105,6 +117,16 @@ func (m *MachineScope) SetReady() {
 	m.YandexMachine.Status.Ready = true
 }
 
+// SetNotReady sets the YandexMachine Ready Status to false.
+func (m *MachineScope) SetNotReady() {
+	m.YandexMachine.Status.Ready = false
+}
+
+// IsPreemptible returns true if the YandexMachine instance is preemptible.
+func (m *MachineScope) IsPreemptible() bool {
+	return ptr.Deref(m.YandexMachine.Spec.Preemptible, false)
+}
+
 // GetProviderID returns the YandexMachine providerID from the spec.
 func (m *MachineScope) GetProviderID() string {
 	if m.YandexMachine.Spec.ProviderID != nil {
@@ -129,6 +151,87 @@ func (m *MachineScope) GetAddresses() []corev1.NodeAddress {
 	return m.YandexMachine.Status.Addresses
 }
 
+// GetBootImageID returns the identifier of the YandexMachine boot disk image.
+// The image resolved from the image family and recorded in the status takes precedence over the specification.
+func (m *MachineScope) GetBootImageID() string {
+	if m.YandexMachine.Status.BootImageID != "" {
+		return m.YandexMachine.Status.BootImageID
+	}
+
+	return m.YandexMachine.Spec.BootDisk.ImageID
+}
+
+// SetBootImageID sets the identifier of the YandexMachine boot disk image.
+func (m *MachineScope) SetBootImageID(id string) {
+	m.YandexMachine.Status.BootImageID = id
+}
+
+// GetBootImageFamily returns the folder and the name of the YandexMachine boot disk image family.
+// If the image family folder is not set, the cluster folder is returned.
+func (m *MachineScope) GetBootImageFamily() (string, string) {
+	family := m.YandexMachine.Spec.BootDisk.ImageFamily
+	if family == nil {
+		return "", ""
+	}
+
+	if family.FolderID != "" {
+		return family.FolderID, family.Name
+	}
+
+	return m.ClusterGetter.GetFolderID(), family.Name
+}
+
+// SetSecondaryDisks sets the secondary disks of the YandexMachine instance.
+func (m *MachineScope) SetSecondaryDisks(disks []infrav1.DiskStatus) {
+	m.YandexMachine.Status.SecondaryDisks = disks
+}
+
+// GetLoadBalancerInterfaceIndex returns the index of the network interface registered in the control plane load balancer.
+func (m *MachineScope) GetLoadBalancerInterfaceIndex() int {
+	return getLoadBalancerInterfaceIndex(m.YandexMachine.Spec.NetworkInterfaces)
+}
+
+// GetLoadBalancerTarget returns the internal address and the subnet ID of the network interface
+// registered in the control plane load balancer.
+// The IPv6 address is used for the NLB load balancer with the IPv6 listener, the IPv4 address otherwise.
+// Returns an empty address if the instance addresses are not known yet.
+func (m *MachineScope) GetLoadBalancerTarget() (string, string) {
+	index := m.GetLoadBalancerInterfaceIndex()
+	if index >= len(m.YandexMachine.Spec.NetworkInterfaces) {
+		return "", ""
+	}
+	subnetID := m.GetSubnetID(m.YandexMachine.Spec.NetworkInterfaces[index])
+
+	isTargetIP := isIPv4
+	lbs := m.ClusterGetter.GetLBSpec()
+	if lbs.Type == infrav1.LoadBalancerTypeNLB && lbs.Listener.IPVersion == infrav1.IPVersionIPv6 {
+		if !hasIPv6(m.YandexMachine.Spec.NetworkInterfaces[index]) {
+			return "", subnetID
+		}
+		// The internal IPv6 addresses are listed for the IPv6 enabled network interfaces only.
+		isTargetIP = isIPv6
+		for _, networkInterface := range m.YandexMachine.Spec.NetworkInterfaces[:index] {
+			if !hasIPv6(networkInterface) {
+				index--
+			}
+		}
+	}
+
+	// The internal addresses are listed in the network interfaces order.
+	i := 0
+	for _, address := range m.GetAddresses() {
+		if address.Type != corev1.NodeInternalIP || !isTargetIP(address.Address) {
+			continue
+		}
+		if i == index {
+			return address.Address, subnetID
+		}
+		i++
+	}
+
+	return "", subnetID
+}
+
 // GetInstanceStatus returns the YandexMachine instance status.
 func (m *MachineScope) GetInstanceStatus() *infrav1.InstanceStatus {
 	return m.YandexMachine.Status.InstanceStatus
@@ -139,20 +242,66 @@ func (m *MachineScope) SetInstanceStatus(v infrav1.InstanceStatus) {
 	m.YandexMachine.Status.InstanceStatus = &v
 }
 
+// IsNodeJoined returns true if the Machine's node has joined the cluster.
+func (m *MachineScope) IsNodeJoined() bool {
+	return m.Machine.Status.NodeRef != nil
+}
+
+// GetBootstrapDataObjectKey returns the object storage key of the YandexMachine bootstrap data.
+// The key contains the YandexMachine UID, so the recreated machine with the same name gets a new object.
+func (m *MachineScope) GetBootstrapDataObjectKey() string {
+	return path.Join(m.Namespace(), m.Name(), string(m.YandexMachine.UID))
+}
+
+// GetBootstrapDataObject returns the object storage key of the uploaded bootstrap data.
+func (m *MachineScope) GetBootstrapDataObject() string {
+	return m.YandexMachine.Status.BootstrapDataObject
+}
+
+// SetBootstrapDataObject sets the object storage key of the uploaded bootstrap data.
+func (m *MachineScope) SetBootstrapDataObject(key string) {
+	m.YandexMachine.Status.BootstrapDataObject = key
+}
+
+// SetBootstrapDataURL sets the URL the instance downloads the bootstrap data from.
+// If the URL is set, the instance user-data contains the cloud-init include stub instead of the bootstrap data.
+func (m *MachineScope) SetBootstrapDataURL(url string) {
+	m.bootstrapDataURL = url
+}
+
+// getUserData returns the instance user-data, which is either the bootstrap data
+// or the stub downloading the bootstrap data from the object storage.
+func (m *MachineScope) getUserData() (string, error) {
+	data, format, err := m.getBootstrapDataWithFormat()
+	if err != nil {
+		return "", err
+	}
+	if err := checkBootstrapFormat(m.YandexMachine.Spec.BootstrapFormat, format); err != nil {
+		return "", err
+	}
+
+	if m.bootstrapDataURL == "" {
+		return data, nil
+	}
+	return getUserDataStub(data, format, m.bootstrapDataURL)
+}
+
 // GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
 func (m *MachineScope) GetBootstrapData() (string, error) {
+	data, _, err := m.getBootstrapDataWithFormat()
+	return data, err
+}
+
+// getBootstrapDataWithFormat returns the bootstrap data and its format from the secret
+// in the Machine's bootstrap.dataSecretName.
+func (m *MachineScope) getBootstrapDataWithFormat() (string, infrav1.BootstrapFormat, error) {
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.Namespace(), Name: *m.Machine.Spec.Bootstrap.DataSecretName}
 	if err := m.client.Get(context.TODO(), key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for YandexMachine %s/%s", m.Namespace(), m.Name())
+		return "", "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for YandexMachine %s/%s", m.Namespace(), m.Name())
 	}
 
-	value, ok := secret.Data["value"]
-	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
-	}
-
-	return string(value), nil
+	return getBootstrapSecretData(secret)
 }
 
 // SetFailureMessage sets the YandexMachine status failure message.
@@ -180,60 +329,116 @@ func parseProviderID(id string) string {
 	return strings.TrimPrefix(id, ProviderIDPrefix)
 }
 
+// GetZoneID returns the YandexCloud availability zone of the machine.
+// The zone from the YandexMachine specification takes precedence over the Machine failure domain.
+func (m *MachineScope) GetZoneID() string {
+	if m.YandexMachine.Spec.ZoneID != nil && *m.YandexMachine.Spec.ZoneID != "" {
+		return *m.YandexMachine.Spec.ZoneID
+	}
+
+	if m.Machine.Spec.FailureDomain != nil && *m.Machine.Spec.FailureDomain != "" {
+		return *m.Machine.Spec.FailureDomain
+	}
+
+	return defaultZoneID
+}
+
+// GetSubnetID returns the subnet ID of the machine network interface.
+// If the network interface does not set the subnet, the subnet of the machine failure domain is returned.
+func (m *MachineScope) GetSubnetID(networkInterface infrav1.NetworkInterface) string {
+	if networkInterface.SubnetID != "" {
+		return networkInterface.SubnetID
+	}
+
+	return m.ClusterGetter.GetFailureDomainSubnetID(m.GetZoneID())
+}
+
+// GetPlacementGroupID returns the placement group of the machine.
+// The placement group from the YandexMachine specification takes precedence over
+// the managed control plane placement group, which is used for the control plane machines only.
+func (m *MachineScope) GetPlacementGroupID() string {
+	if m.YandexMachine.Spec.PlacementGroupID != "" {
+		return m.YandexMachine.Spec.PlacementGroupID
+	}
+
+	if m.IsControlPlane() {
+		return m.ClusterGetter.GetControlPlanePlacementGroupID()
+	}
+
+	return ""
+}
+
 // GetInstanceReq returns YandexCloud compute instance creation request.
 func (m *MachineScope) GetInstanceReq() (*compute.CreateInstanceRequest, error) {
-	bootstrapData, err := m.GetBootstrapData()
+	bootstrapData, err := m.getUserData()
 	if err != nil {
 		return nil, err
 	}
 
-	memory, ok := m.YandexMachine.Spec.Resources.Memory.AsInt64()
-	if !ok {
-		return nil, errors.New("failed to parse instance's memory from yandex machine specification")
-	}
-	resourcesSpec := &compute.ResourcesSpec{
-		Cores:  m.YandexMachine.Spec.Resources.Cores,
-		Memory: memory,
-	}
-	if m.YandexMachine.Spec.Resources.GPUs != nil {
-		resourcesSpec.Gpus = *m.YandexMachine.Spec.Resources.GPUs
-	}
-	if m.YandexMachine.Spec.Resources.CoreFraction != nil {
-		resourcesSpec.CoreFraction = *m.YandexMachine.Spec.Resources.CoreFraction
+	resourcesSpec, err := m.getResourcesSpec()
+	if err != nil {
+		return nil, err
 	}
 
 	networkInterfacesSpecs := make([]*compute.NetworkInterfaceSpec, 0)
 
-	for _, networkInterface := range m.YandexMachine.Spec.NetworkInterfaces {
+	zoneID := m.GetZoneID()
+	for i, networkInterface := range m.YandexMachine.Spec.NetworkInterfaces {
+		subnetID := m.GetSubnetID(networkInterface)
+		if subnetID == "" {
+			return nil, fmt.Errorf("subnet for the network interface %d is not set and no failure domain found for the zone %s", i, zoneID)
+		}
+
+		address, err := m.getInterfaceAddress(i, networkInterface)
+		if err != nil {
+			return nil, err
+		}
+
 		networkInterfaceSpec := &compute.NetworkInterfaceSpec{
-			SubnetId:             networkInterface.SubnetID,
-			PrimaryV4AddressSpec: &compute.PrimaryAddressSpec{},
+			SubnetId:             subnetID,
+			PrimaryV4AddressSpec: &compute.PrimaryAddressSpec{Address: address},
+			SecurityGroupIds: getSecurityGroupIDs(
+				m.YandexMachine.Spec.NetworkInterfaces, i, m.ClusterGetter.GetNodeSecurityGroupID()),
 		}
 		if networkInterface.HasPublicIP != nil && *networkInterface.HasPublicIP {
-			networkInterfaceSpec.PrimaryV4AddressSpec = &compute.PrimaryAddressSpec{
-				OneToOneNatSpec: &compute.OneToOneNatSpec{
-					IpVersion: compute.IpVersion_IPV4,
-				},
+			networkInterfaceSpec.PrimaryV4AddressSpec.OneToOneNatSpec = &compute.OneToOneNatSpec{
+				IpVersion: compute.IpVersion_IPV4,
 			}
 		}
+		if hasIPv6(networkInterface) {
+			networkInterfaceSpec.PrimaryV6AddressSpec = &compute.PrimaryAddressSpec{}
+		}
 		networkInterfacesSpecs = append(networkInterfacesSpecs, networkInterfaceSpec)
 	}
 	bootDiskSize, ok := m.YandexMachine.Spec.BootDisk.Size.AsInt64()
 	if !ok {
 		return nil, errors.New("failed to parse instance's boot disk size from yandex machine specification")
 	}
+	bootImageID := m.GetBootImageID()
+	if bootImageID == "" {
+		return nil, errors.New("boot disk image of yandex machine is not resolved")
+	}
 
-	return &compute.CreateInstanceRequest{
-		FolderId:   m.ClusterGetter.GetFolderID(),
-		Name:       m.YandexMachine.GetName(),
-		ZoneId:     *m.YandexMachine.Spec.ZoneID,
-		PlatformId: *m.YandexMachine.Spec.PlatformID,
-		Metadata: map[string]string{
-			"user-data": bootstrapData,
-		},
-		Labels:        m.getMachineLabels(),
-		Hostname:      m.YandexMachine.GetName(),
-		ResourcesSpec: resourcesSpec,
+	secondaryDiskSpecs, localDiskSpecs, err := m.getSecondaryDiskSpecs()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := getInstanceMetadata(context.TODO(), m.client, m.Namespace(), &m.YandexMachine.Spec, bootstrapData)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &compute.CreateInstanceRequest{
+		FolderId:         m.ClusterGetter.GetFolderID(),
+		Name:             m.YandexMachine.GetName(),
+		ZoneId:           zoneID,
+		PlatformId:       *m.YandexMachine.Spec.PlatformID,
+		Metadata:         metadata,
+		Labels:           m.getMachineLabels(),
+		Hostname:         m.YandexMachine.GetName(),
+		ServiceAccountId: m.YandexMachine.Spec.ServiceAccountID,
+		ResourcesSpec:    resourcesSpec,
 		BootDiskSpec: &compute.AttachedDiskSpec{
 			AutoDelete: true,
 			Disk: &compute.AttachedDiskSpec_DiskSpec_{
@@ -241,11 +446,130 @@ func (m *MachineScope) GetInstanceReq() (*compute.CreateInstanceRequest, error)
 					TypeId: *m.YandexMachine.Spec.BootDisk.TypeID,
 					Size:   bootDiskSize,
 					Source: &compute.AttachedDiskSpec_DiskSpec_ImageId{
-						ImageId: m.YandexMachine.Spec.BootDisk.ImageID,
+						ImageId: bootImageID,
 					},
 				},
 			},
 		},
+		SecondaryDiskSpecs:    secondaryDiskSpecs,
+		LocalDiskSpecs:        localDiskSpecs,
 		NetworkInterfaceSpecs: networkInterfacesSpecs,
-	}, nil
+	}
+	if m.IsPreemptible() {
+		req.SchedulingPolicy = &compute.SchedulingPolicy{Preemptible: true}
+	}
+	if placementGroupID := m.GetPlacementGroupID(); placementGroupID != "" {
+		req.PlacementPolicy = &compute.PlacementPolicy{PlacementGroupId: placementGroupID}
+	}
+	if hostGroupID := m.YandexMachine.Spec.HostGroupID; hostGroupID != "" {
+		if req.PlacementPolicy == nil {
+			req.PlacementPolicy = &compute.PlacementPolicy{}
+		}
+		req.PlacementPolicy.HostAffinityRules = []*compute.PlacementPolicy_HostAffinityRule{{
+			Key:    hostGroupAffinityKey,
+			Op:     compute.PlacementPolicy_HostAffinityRule_IN,
+			Values: []string{hostGroupID},
+		}}
+	}
+
+	return req, nil
+}
+
+// getResourcesSpec returns the instance resources from YandexMachine specification.
+func (m *MachineScope) getResourcesSpec() (*compute.ResourcesSpec, error) {
+	memory, ok := m.YandexMachine.Spec.Resources.Memory.AsInt64()
+	if !ok {
+		return nil, errors.New("failed to parse instance's memory from yandex machine specification")
+	}
+	resourcesSpec := &compute.ResourcesSpec{
+		Cores:  m.YandexMachine.Spec.Resources.Cores,
+		Memory: memory,
+	}
+	if m.YandexMachine.Spec.Resources.GPUs != nil {
+		resourcesSpec.Gpus = *m.YandexMachine.Spec.Resources.GPUs
+	}
+	if m.YandexMachine.Spec.Resources.CoreFraction != nil {
+		resourcesSpec.CoreFraction = *m.YandexMachine.Spec.Resources.CoreFraction
+	}
+
+	return resourcesSpec, nil
+}
+
+// getSecondaryDiskSpecs returns the network and local secondary disk specifications of YandexCloud VM.
+func (m *MachineScope) getSecondaryDiskSpecs() ([]*compute.AttachedDiskSpec, []*compute.AttachedLocalDiskSpec, error) {
+	var diskSpecs []*compute.AttachedDiskSpec
+	var localDiskSpecs []*compute.AttachedLocalDiskSpec
+
+	for i, disk := range m.YandexMachine.Spec.SecondaryDisks {
+		size, ok := disk.Size.AsInt64()
+		if !ok {
+			return nil, nil, fmt.Errorf("failed to parse secondary disk %d size from yandex machine specification", i)
+		}
+
+		typeID := ptr.Deref(disk.TypeID, "")
+		if typeID == infrav1.DiskTypeLocal {
+			localDiskSpecs = append(localDiskSpecs, &compute.AttachedLocalDiskSpec{Size: size})
+			continue
+		}
+
+		diskSpec := &compute.AttachedDiskSpec_DiskSpec{
+			TypeId: typeID,
+			Size:   size,
+		}
+		switch {
+		case disk.ImageID != "":
+			diskSpec.Source = &compute.AttachedDiskSpec_DiskSpec_ImageId{ImageId: disk.ImageID}
+		case disk.SnapshotID != "":
+			diskSpec.Source = &compute.AttachedDiskSpec_DiskSpec_SnapshotId{SnapshotId: disk.SnapshotID}
+		}
+
+		diskSpecs = append(diskSpecs, &compute.AttachedDiskSpec{
+			Mode:       compute.AttachedDiskSpec_READ_WRITE,
+			DeviceName: disk.DeviceName,
+			AutoDelete: ptr.Deref(disk.AutoDelete, true),
+			Disk:       &compute.AttachedDiskSpec_DiskSpec_{DiskSpec: diskSpec},
+		})
+	}
+
+	return diskSpecs, localDiskSpecs, nil
+}
+
+// getLoadBalancerInterfaceIndex returns the index of the network interface marked as the load balancer target,
+// or the first network interface if none is marked.
+func getLoadBalancerInterfaceIndex(interfaces []infrav1.NetworkInterface) int {
+	for i, networkInterface := range interfaces {
+		if networkInterface.LoadBalancerTarget {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// getSecurityGroupIDs returns the security groups of the network interface.
+// The managed node security group is attached to the load balancer target network interface,
+// which resides in the cluster network.
+func getSecurityGroupIDs(interfaces []infrav1.NetworkInterface, index int, nodeSecurityGroupID string) []string {
+	ids := interfaces[index].SecurityGroupIDs
+	if nodeSecurityGroupID != "" && index == getLoadBalancerInterfaceIndex(interfaces) {
+		ids = append(append([]string{}, ids...), nodeSecurityGroupID)
+	}
+	return ids
+}
+
+// isIPv4 returns true if the address is a valid IPv4 address.
+func isIPv4(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() != nil
+}
+
+// isIPv6 returns true if the address is a valid IPv6 address.
+func isIPv6(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.To4() == nil
+}
+
+// hasIPv6 checks if the network interface has to get an internal IPv6 address.
+func hasIPv6(networkInterface infrav1.NetworkInterface) bool {
+	return networkInterface.HasIPv6 != nil && *networkInterface.HasIPv6
 }
@@ -25,10 +25,14 @@ import (
 	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -59,6 +63,49 @@ func TestMachineScope_ProviderID(t *testing.T) {
 	})
 }
 
+func TestMachineScope_FailureDomain(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterScope := &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{
+			Spec: infrav1.YandexClusterSpec{
+				FailureDomains: []infrav1.FailureDomainSpec{
+					{ZoneID: "ru-central1-a", SubnetID: "subnet-a", ControlPlane: true},
+				},
+			},
+		},
+	}
+
+	t.Run("GetZoneID should return default zone, if neither zone nor failure domain set", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			Machine:       &v1beta1.Machine{},
+			YandexMachine: &infrav1.YandexMachine{},
+		}
+		g.Expect(scp.GetZoneID()).To(Equal("ru-central1-d"))
+	})
+
+	t.Run("GetZoneID and GetSubnetID should use Machine failure domain, if YandexMachine leaves them unset", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			Machine:       &v1beta1.Machine{Spec: v1beta1.MachineSpec{FailureDomain: ptr.To("ru-central1-a")}},
+			YandexMachine: &infrav1.YandexMachine{},
+		}
+		g.Expect(scp.GetZoneID()).To(Equal("ru-central1-a"))
+		g.Expect(scp.GetSubnetID(infrav1.NetworkInterface{})).To(Equal("subnet-a"))
+	})
+
+	t.Run("GetZoneID and GetSubnetID should prefer YandexMachine specification", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			Machine:       &v1beta1.Machine{Spec: v1beta1.MachineSpec{FailureDomain: ptr.To("ru-central1-a")}},
+			YandexMachine: &infrav1.YandexMachine{Spec: infrav1.YandexMachineSpec{ZoneID: ptr.To("ru-central1-b")}},
+		}
+		g.Expect(scp.GetZoneID()).To(Equal("ru-central1-b"))
+		g.Expect(scp.GetSubnetID(infrav1.NetworkInterface{SubnetID: "subnet-b"})).To(Equal("subnet-b"))
+	})
+}
+
 func TestMachineScope_GetBootstrapData(t *testing.T) {
 	g := NewWithT(t)
 	want := "bootstrap-data"
@@ -88,6 +135,578 @@ func TestMachineScope_GetBootstrapData(t *testing.T) {
 	g.Expect(get).To(BeZero())
 }
 
+func TestMachineScope_GetInstanceReq(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data:       map[string][]byte{"value": []byte("bootstrap-data")},
+	}
+	scp, err := fakeScopeWithSecret(&secret)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	scp.ClusterGetter = &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{
+			Spec:   infrav1.YandexClusterSpec{FolderID: "folder-id"},
+			Status: infrav1.YandexClusterStatus{NodeSecurityGroupID: "node-security-group"},
+		},
+	}
+	scp.YandexMachine.Spec = infrav1.YandexMachineSpec{
+		ZoneID:     ptr.To("ru-central1-a"),
+		PlatformID: ptr.To("standard-v3"),
+		BootDisk: &infrav1.Disk{
+			TypeID:  ptr.To("network-ssd"),
+			Size:    resource.MustParse("20Gi"),
+			ImageID: "image-id",
+		},
+		Resources: infrav1.Resources{
+			Memory: resource.MustParse("4Gi"),
+			Cores:  2,
+		},
+		NetworkInterfaces: []infrav1.NetworkInterface{
+			{SubnetID: "subnet-id", HasIPv6: ptr.To(true), SecurityGroupIDs: []string{"security-group"}},
+			{SubnetID: "storage-subnet-id", SecurityGroupIDs: []string{"storage-security-group"}},
+		},
+		ServiceAccountID: "ajeabcdefgh123456789",
+		SecondaryDisks: []infrav1.SecondaryDisk{
+			{
+				TypeID:     ptr.To("network-ssd-nonreplicated"),
+				Size:       resource.MustParse("93Gi"),
+				DeviceName: "etcd",
+				AutoDelete: ptr.To(false),
+			},
+			{
+				TypeID:     ptr.To("network-hdd"),
+				Size:       resource.MustParse("10Gi"),
+				SnapshotID: "snapshot-id",
+			},
+			{
+				TypeID: ptr.To(infrav1.DiskTypeLocal),
+				Size:   resource.MustParse("368Gi"),
+			},
+		},
+	}
+
+	req, err := scp.GetInstanceReq()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(req.GetServiceAccountId()).To(Equal("ajeabcdefgh123456789"))
+	g.Expect(req.GetNetworkInterfaceSpecs()[0].GetPrimaryV6AddressSpec()).ToNot(BeNil())
+	g.Expect(req.GetNetworkInterfaceSpecs()[0].GetSecurityGroupIds()).To(Equal([]string{"security-group", "node-security-group"}))
+	g.Expect(req.GetNetworkInterfaceSpecs()[1].GetSecurityGroupIds()).To(Equal([]string{"storage-security-group"}))
+
+	disks := req.GetSecondaryDiskSpecs()
+	g.Expect(disks).To(HaveLen(2))
+	g.Expect(disks[0].GetDeviceName()).To(Equal("etcd"))
+	g.Expect(disks[0].GetAutoDelete()).To(BeFalse())
+	g.Expect(disks[0].GetDiskSpec().GetTypeId()).To(Equal("network-ssd-nonreplicated"))
+	g.Expect(disks[0].GetDiskSpec().GetSize()).To(Equal(int64(93 << 30)))
+	g.Expect(disks[1].GetAutoDelete()).To(BeTrue())
+	g.Expect(disks[1].GetDiskSpec().GetSnapshotId()).To(Equal("snapshot-id"))
+
+	g.Expect(req.GetLocalDiskSpecs()).To(HaveLen(1))
+	g.Expect(req.GetLocalDiskSpecs()[0].GetSize()).To(Equal(int64(368 << 30)))
+}
+
+func TestMachineScope_GetInstanceReqMetadata(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data: map[string][]byte{
+			"value":     []byte("bootstrap-data"),
+			"admin.pub": []byte("ssh-ed25519 BBBB admin@host\n"),
+			"multi.pub": []byte("ssh-ed25519 CCCC admin@host\nroot:ssh-ed25519 DDDD attacker@host\n"),
+		},
+	}
+	scp, err := fakeScopeWithSecret(&secret)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	scp.ClusterGetter = &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{Spec: infrav1.YandexClusterSpec{FolderID: "folder-id"}},
+	}
+	scp.YandexMachine.Spec = infrav1.YandexMachineSpec{
+		PlatformID: ptr.To("standard-v3"),
+		BootDisk: &infrav1.Disk{
+			TypeID:  ptr.To("network-ssd"),
+			Size:    resource.MustParse("20Gi"),
+			ImageID: "image-id",
+		},
+		Resources: infrav1.Resources{
+			Memory: resource.MustParse("4Gi"),
+			Cores:  2,
+		},
+		NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-id"}},
+		Metadata: map[string]string{
+			"serial-port-enable": "1",
+			"user-data":          "overwritten",
+		},
+		SSHKeys: []infrav1.SSHKey{
+			{User: "ubuntu", PublicKey: "ssh-ed25519 AAAA ubuntu@host"},
+			{User: "admin", PublicKeySecretRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "test-secret"},
+				Key:                  "admin.pub",
+			}},
+		},
+	}
+
+	t.Run("GetInstanceReq should merge metadata with bootstrap data and SSH keys", func(_ *testing.T) {
+		req, err := scp.GetInstanceReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetMetadata()).To(Equal(map[string]string{
+			"serial-port-enable": "1",
+			"user-data":          "bootstrap-data",
+			"ssh-keys":           "ubuntu:ssh-ed25519 AAAA ubuntu@host\nadmin:ssh-ed25519 BBBB admin@host",
+		}))
+	})
+
+	t.Run("GetInstanceReq should pass object storage include stub instead of bootstrap data", func(_ *testing.T) {
+		scp.SetBootstrapDataURL("https://storage.example.com/bootstrap/object")
+		defer scp.SetBootstrapDataURL("")
+
+		req, err := scp.GetInstanceReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetMetadata()).To(HaveKeyWithValue("user-data", "#include\nhttps://storage.example.com/bootstrap/object\n"))
+	})
+
+	t.Run("GetInstanceReq should fail, if SSH key secret key is missing", func(_ *testing.T) {
+		scp.YandexMachine.Spec.SSHKeys[1].PublicKeySecretRef.Key = "missing.pub"
+		_, err := scp.GetInstanceReq()
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("GetInstanceReq should fail, if SSH key secret value has several lines", func(_ *testing.T) {
+		scp.YandexMachine.Spec.SSHKeys[1].PublicKeySecretRef.Key = "multi.pub"
+		_, err := scp.GetInstanceReq()
+		g.Expect(err).To(MatchError(ContainSubstring("must be a single line")))
+	})
+}
+
+func TestMachineScope_GetInstanceReqIgnition(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data: map[string][]byte{
+			"value":  []byte(`{"ignition":{"version":"3.3.0"},"storage":{}}`),
+			"format": []byte("ignition"),
+		},
+	}
+	scp, err := fakeScopeWithSecret(&secret)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	scp.ClusterGetter = &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{Spec: infrav1.YandexClusterSpec{FolderID: "folder-id"}},
+	}
+	scp.YandexMachine.Spec = infrav1.YandexMachineSpec{
+		PlatformID: ptr.To("standard-v3"),
+		BootDisk: &infrav1.Disk{
+			TypeID:  ptr.To("network-ssd"),
+			Size:    resource.MustParse("20Gi"),
+			ImageID: "image-id",
+		},
+		Resources: infrav1.Resources{
+			Memory: resource.MustParse("4Gi"),
+			Cores:  2,
+		},
+		NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-id"}},
+		BootstrapFormat:   infrav1.BootstrapFormatIgnition,
+	}
+
+	t.Run("GetInstanceReq should pass Ignition config in user-data", func(_ *testing.T) {
+		req, err := scp.GetInstanceReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetMetadata()).To(HaveKeyWithValue("user-data", `{"ignition":{"version":"3.3.0"},"storage":{}}`))
+	})
+
+	t.Run("GetInstanceReq should pass Ignition replace stub for object storage", func(_ *testing.T) {
+		scp.SetBootstrapDataURL("https://storage.example.com/bootstrap/object")
+		defer scp.SetBootstrapDataURL("")
+
+		req, err := scp.GetInstanceReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetMetadata()["user-data"]).To(MatchJSON(
+			`{"ignition":{"version":"3.3.0","config":{"replace":{"source":"https://storage.example.com/bootstrap/object"}}}}`))
+	})
+
+	t.Run("GetInstanceReq should fail, if bootstrap format does not match", func(_ *testing.T) {
+		scp.YandexMachine.Spec.BootstrapFormat = infrav1.BootstrapFormatCloudConfig
+		_, err := scp.GetInstanceReq()
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestMachineScope_GetInstanceReqHostGroup(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data:       map[string][]byte{"value": []byte("bootstrap-data")},
+	}
+	scp, err := fakeScopeWithSecret(&secret)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	scp.ClusterGetter = &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{Spec: infrav1.YandexClusterSpec{FolderID: "folder-id"}},
+	}
+	scp.YandexMachine.Spec = infrav1.YandexMachineSpec{
+		PlatformID: ptr.To("standard-v3"),
+		BootDisk: &infrav1.Disk{
+			TypeID:  ptr.To("network-ssd"),
+			Size:    resource.MustParse("20Gi"),
+			ImageID: "image-id",
+		},
+		SecondaryDisks: []infrav1.SecondaryDisk{
+			{TypeID: ptr.To(infrav1.DiskTypeLocal), Size: resource.MustParse("368Gi")},
+		},
+		Resources: infrav1.Resources{
+			Memory: resource.MustParse("4Gi"),
+			Cores:  2,
+		},
+		NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-id"}},
+		HostGroupID:       "host-group-id",
+		PlacementGroupID:  "placement-group-id",
+	}
+
+	t.Run("GetInstanceReq should place instance with local disks on dedicated host group", func(_ *testing.T) {
+		req, err := scp.GetInstanceReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetLocalDiskSpecs()).To(HaveLen(1))
+		g.Expect(req.GetPlacementPolicy().GetPlacementGroupId()).To(Equal("placement-group-id"))
+		g.Expect(req.GetPlacementPolicy().GetHostAffinityRules()).To(HaveLen(1))
+		rule := req.GetPlacementPolicy().GetHostAffinityRules()[0]
+		g.Expect(rule.GetKey()).To(Equal("yc.hostGroupId"))
+		g.Expect(rule.GetOp()).To(Equal(compute.PlacementPolicy_HostAffinityRule_IN))
+		g.Expect(rule.GetValues()).To(Equal([]string{"host-group-id"}))
+	})
+}
+
+func TestMachineScope_GetInstanceDrift(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	secret := corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data:       map[string][]byte{"value": []byte("bootstrap-data")},
+	}
+	scp, err := fakeScopeWithSecret(&secret)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	scp.ClusterGetter = &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{Spec: infrav1.YandexClusterSpec{FolderID: "folder-id"}},
+	}
+	scp.YandexMachine.Spec = infrav1.YandexMachineSpec{
+		PlatformID: ptr.To("standard-v3"),
+		BootDisk: &infrav1.Disk{
+			TypeID:  ptr.To("network-ssd"),
+			Size:    resource.MustParse("20Gi"),
+			ImageID: "image-id",
+		},
+		Resources: infrav1.Resources{
+			Memory: resource.MustParse("4Gi"),
+			Cores:  2,
+		},
+		NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-id"}},
+		Metadata:          map[string]string{"serial-port-enable": "1"},
+	}
+
+	req, err := scp.GetInstanceReq()
+	g.Expect(err).ToNot(HaveOccurred())
+	delete(req.Metadata, "user-data")
+	bootDisk := &compute.Disk{TypeId: "network-ssd", Size: 20 << 30}
+
+	t.Run("GetInstanceDrift should report instance created from specification in sync", func(_ *testing.T) {
+		vm := &compute.Instance{
+			PlatformId: "standard-v3",
+			Resources:  &compute.Resources{Cores: 2, Memory: 4 << 30, CoreFraction: 100},
+			Labels:     req.GetLabels(),
+			Metadata:   req.GetMetadata(),
+		}
+		drift, err := scp.GetInstanceDrift(ctx, vm, bootDisk)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(drift.IsInSync()).To(BeTrue())
+	})
+
+	t.Run("GetInstanceDrift should report drifted fields", func(_ *testing.T) {
+		labels := map[string]string{"owner": "someone"}
+		vm := &compute.Instance{
+			PlatformId: "standard-v2",
+			Resources:  &compute.Resources{Cores: 4, Memory: 4 << 30, CoreFraction: 100},
+			Labels:     labels,
+			Metadata:   map[string]string{"serial-port-enable": "0"},
+		}
+		drift, err := scp.GetInstanceDrift(ctx, vm, &compute.Disk{TypeId: "network-hdd", Size: 20 << 30})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(drift.IsInSync()).To(BeFalse())
+		g.Expect(drift.Immutable).To(ConsistOf(
+			"platformID: expected standard-v3, got standard-v2",
+			"resources.cores: expected 2, got 4",
+			"bootDisk.typeID: expected network-ssd, got network-hdd",
+		))
+		g.Expect(drift.Metadata).To(Equal(map[string]string{"serial-port-enable": "1"}))
+		g.Expect(drift.Labels).To(HaveKeyWithValue("owner", "someone"))
+		for key, value := range req.GetLabels() {
+			g.Expect(drift.Labels).To(HaveKeyWithValue(key, value))
+		}
+		g.Expect(drift.Mutable).To(HaveLen(len(req.GetLabels()) + 1))
+	})
+}
+
+func TestMachineScope_GetInstanceResizeReq(t *testing.T) {
+	g := NewWithT(t)
+
+	scp := scope.MachineScope{
+		YandexMachine: &infrav1.YandexMachine{
+			Spec: infrav1.YandexMachineSpec{
+				PlatformID: ptr.To("standard-v3"),
+				Resources: infrav1.Resources{
+					Memory: resource.MustParse("8Gi"),
+					Cores:  4,
+				},
+			},
+		},
+	}
+
+	t.Run("GetInstanceResizeReq should return nil if instance matches specification", func(_ *testing.T) {
+		vm := &compute.Instance{
+			Id:         "instance-id",
+			PlatformId: "standard-v3",
+			Resources:  &compute.Resources{Cores: 4, Memory: 8 << 30, CoreFraction: 100},
+		}
+		req, err := scp.GetInstanceResizeReq(vm)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req).To(BeNil())
+	})
+
+	t.Run("GetInstanceResizeReq should return platform and resources update", func(_ *testing.T) {
+		vm := &compute.Instance{
+			Id:         "instance-id",
+			PlatformId: "standard-v2",
+			Resources:  &compute.Resources{Cores: 2, Memory: 4 << 30, CoreFraction: 100},
+		}
+		req, err := scp.GetInstanceResizeReq(vm)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetInstanceId()).To(Equal("instance-id"))
+		g.Expect(req.GetUpdateMask().GetPaths()).To(ConsistOf("platform_id", "resources_spec"))
+		g.Expect(req.GetPlatformId()).To(Equal("standard-v3"))
+		g.Expect(req.GetResourcesSpec().GetCores()).To(Equal(int64(4)))
+		g.Expect(req.GetResourcesSpec().GetMemory()).To(Equal(int64(8 << 30)))
+		g.Expect(req.GetResourcesSpec().GetCoreFraction()).To(Equal(int64(100)))
+	})
+}
+
+func TestMachineScope_IsInstanceResizing(t *testing.T) {
+	g := NewWithT(t)
+
+	scp := scope.MachineScope{
+		YandexMachine: &infrav1.YandexMachine{},
+	}
+
+	t.Run("IsInPlaceResizeAllowed should return false without annotation", func(_ *testing.T) {
+		g.Expect(scp.IsInPlaceResizeAllowed()).To(BeFalse())
+	})
+
+	t.Run("IsInPlaceResizeAllowed should return true with annotation", func(_ *testing.T) {
+		scp.YandexMachine.SetAnnotations(map[string]string{infrav1.InPlaceResizeAnnotation: "true"})
+		g.Expect(scp.IsInPlaceResizeAllowed()).To(BeTrue())
+	})
+
+	t.Run("IsInstanceResizing should return false if resize has not been started", func(_ *testing.T) {
+		g.Expect(scp.IsInstanceResizing()).To(BeFalse())
+	})
+
+	t.Run("IsInstanceResizing should return true while instance is stopping", func(_ *testing.T) {
+		conditions.MarkFalse(scp.YandexMachine, infrav1.InstanceResizedCondition,
+			infrav1.InstanceStoppingForResizeReason, v1beta1.ConditionSeverityInfo, "")
+		g.Expect(scp.IsInstanceResizing()).To(BeTrue())
+	})
+
+	t.Run("IsInstanceResizing should return false if resize has failed", func(_ *testing.T) {
+		conditions.MarkFalse(scp.YandexMachine, infrav1.InstanceResizedCondition,
+			infrav1.InstanceResizeFailedReason, v1beta1.ConditionSeverityError, "")
+		g.Expect(scp.IsInstanceResizing()).To(BeFalse())
+	})
+
+	t.Run("IsInstanceResizing should return false if resize is done", func(_ *testing.T) {
+		conditions.MarkTrue(scp.YandexMachine, infrav1.InstanceResizedCondition)
+		g.Expect(scp.IsInstanceResizing()).To(BeFalse())
+	})
+}
+
+func TestMachineScope_BootImage(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterScope := &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{Spec: infrav1.YandexClusterSpec{FolderID: "cluster-folder"}},
+	}
+
+	t.Run("GetBootImageID should return image from specification, if it is not resolved yet", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{BootDisk: &infrav1.Disk{ImageID: "image-id"}},
+			},
+		}
+		g.Expect(scp.GetBootImageID()).To(Equal("image-id"))
+	})
+
+	t.Run("GetBootImageID should prefer image recorded in status", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{
+					BootDisk: &infrav1.Disk{ImageFamily: &infrav1.ImageFamily{Name: "ubuntu-2204-lts"}},
+				},
+			},
+		}
+		g.Expect(scp.GetBootImageID()).To(BeEmpty())
+		scp.SetBootImageID("resolved-image-id")
+		g.Expect(scp.GetBootImageID()).To(Equal("resolved-image-id"))
+	})
+
+	t.Run("GetBootImageFamily should use cluster folder, if image family folder is not set", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{
+					BootDisk: &infrav1.Disk{ImageFamily: &infrav1.ImageFamily{Name: "ubuntu-2204-lts"}},
+				},
+			},
+		}
+		folderID, family := scp.GetBootImageFamily()
+		g.Expect(folderID).To(Equal("cluster-folder"))
+		g.Expect(family).To(Equal("ubuntu-2204-lts"))
+
+		scp.YandexMachine.Spec.BootDisk.ImageFamily.FolderID = "standard-images"
+		folderID, _ = scp.GetBootImageFamily()
+		g.Expect(folderID).To(Equal("standard-images"))
+	})
+}
+
+func TestMachineScope_GetPlacementGroupID(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterScope := &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{
+			Status: infrav1.YandexClusterStatus{ControlPlanePlacementGroupID: "control-plane-group"},
+		},
+	}
+	controlPlaneMachine := &v1beta1.Machine{
+		ObjectMeta: v1.ObjectMeta{Labels: map[string]string{v1beta1.MachineControlPlaneLabel: ""}},
+	}
+
+	t.Run("GetPlacementGroupID should return managed placement group for control plane machine", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			Machine:       controlPlaneMachine,
+			YandexMachine: &infrav1.YandexMachine{},
+		}
+		g.Expect(scp.GetPlacementGroupID()).To(Equal("control-plane-group"))
+	})
+
+	t.Run("GetPlacementGroupID should not return managed placement group for worker machine", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			Machine:       &v1beta1.Machine{},
+			YandexMachine: &infrav1.YandexMachine{},
+		}
+		g.Expect(scp.GetPlacementGroupID()).To(BeEmpty())
+	})
+
+	t.Run("GetPlacementGroupID should prefer YandexMachine specification", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			Machine:       controlPlaneMachine,
+			YandexMachine: &infrav1.YandexMachine{Spec: infrav1.YandexMachineSpec{PlacementGroupID: "existing-group"}},
+		}
+		g.Expect(scp.GetPlacementGroupID()).To(Equal("existing-group"))
+	})
+}
+
+func TestMachineScope_GetLoadBalancerTarget(t *testing.T) {
+	g := NewWithT(t)
+
+	addresses := []corev1.NodeAddress{
+		{Type: corev1.NodeInternalIP, Address: "10.0.0.10"},
+		{Type: corev1.NodeInternalIP, Address: "10.1.0.10"},
+		{Type: corev1.NodeInternalIP, Address: "2a02:6b8:c0::10"},
+		{Type: corev1.NodeExternalIP, Address: "158.160.0.10"},
+	}
+	clusterScope := &scope.ClusterScope{
+		YandexCluster: &infrav1.YandexCluster{},
+	}
+
+	t.Run("GetLoadBalancerTarget should return first interface address, if no interface is marked", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{
+					NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-a"}, {SubnetID: "subnet-b"}},
+				},
+				Status: infrav1.YandexMachineStatus{Addresses: addresses},
+			},
+		}
+		address, subnetID := scp.GetLoadBalancerTarget()
+		g.Expect(address).To(Equal("10.0.0.10"))
+		g.Expect(subnetID).To(Equal("subnet-a"))
+	})
+
+	t.Run("GetLoadBalancerTarget should return marked interface address", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{
+					NetworkInterfaces: []infrav1.NetworkInterface{
+						{SubnetID: "subnet-a"},
+						{SubnetID: "subnet-b", LoadBalancerTarget: true},
+					},
+				},
+				Status: infrav1.YandexMachineStatus{Addresses: addresses},
+			},
+		}
+		address, subnetID := scp.GetLoadBalancerTarget()
+		g.Expect(address).To(Equal("10.1.0.10"))
+		g.Expect(subnetID).To(Equal("subnet-b"))
+	})
+
+	t.Run("GetLoadBalancerTarget should return empty address, if addresses are unknown", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: clusterScope,
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{
+					NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-a"}},
+				},
+			},
+		}
+		address, _ := scp.GetLoadBalancerTarget()
+		g.Expect(address).To(BeEmpty())
+	})
+
+	t.Run("GetLoadBalancerTarget should return IPv6 address for IPv6 network load balancer", func(_ *testing.T) {
+		scp := scope.MachineScope{
+			ClusterGetter: &scope.ClusterScope{
+				YandexCluster: &infrav1.YandexCluster{
+					Spec: infrav1.YandexClusterSpec{
+						LoadBalancer: infrav1.LoadBalancerSpec{
+							Type:     infrav1.LoadBalancerTypeNLB,
+							Listener: infrav1.ListenerSpec{IPVersion: infrav1.IPVersionIPv6},
+						},
+					},
+				},
+			},
+			YandexMachine: &infrav1.YandexMachine{
+				Spec: infrav1.YandexMachineSpec{
+					NetworkInterfaces: []infrav1.NetworkInterface{
+						{SubnetID: "subnet-a"},
+						{SubnetID: "subnet-b", HasIPv6: ptr.To(true), LoadBalancerTarget: true},
+					},
+				},
+				Status: infrav1.YandexMachineStatus{Addresses: addresses},
+			},
+		}
+		address, subnetID := scp.GetLoadBalancerTarget()
+		g.Expect(address).To(Equal("2a02:6b8:c0::10"))
+		g.Expect(subnetID).To(Equal("subnet-b"))
+	})
+}
+
 // fakeScopeWithSecret creates machine scope with fakeclient.
 func fakeScopeWithSecret(secret *corev1.Secret) (*scope.MachineScope, error) {
 	scheme := runtime.NewScheme()
@@ -130,3 +749,15 @@ func fakeScopeWithSecret(secret *corev1.Secret) (*scope.MachineScope, error) {
 
 	return scp, nil
 }
+
+func TestMachineScope_IsPreemptible(t *testing.T) {
+	g := NewWithT(t)
+
+	scp := scope.MachineScope{
+		YandexMachine: &infrav1.YandexMachine{},
+	}
+	g.Expect(scp.IsPreemptible()).To(BeFalse())
+
+	scp.YandexMachine.Spec.Preemptible = ptr.To(true)
+	g.Expect(scp.IsPreemptible()).To(BeTrue())
+}
@@ -0,0 +1,423 @@
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MachinePoolScopeParams defines the input parameters used to create a new MachinePoolScope.
+type MachinePoolScopeParams struct {
+	Client            client.Client
+	ClusterGetter     cloud.ClusterGetter
+	MachinePool       *expclusterv1.MachinePool
+	YandexMachinePool *infrav1.YandexMachinePool
+}
+
+// NewMachinePoolScope is meant to be called for each reconcile iteration.
+func NewMachinePoolScope(params MachinePoolScopeParams) (*MachinePoolScope, error) {
+	if params.Client == nil {
+		return nil, errors.New("Client is required when creating a MachinePoolScope")
+	}
+	if params.MachinePool == nil {
+		return nil, errors.New("MachinePool is required when creating a MachinePoolScope")
+	}
+	if params.YandexMachinePool == nil {
+		return nil, errors.New("YandexMachinePool is required when creating a MachinePoolScope")
+	}
+	if params.ClusterGetter == nil {
+		return nil, errors.New("cluster getter is required when creating a MachinePoolScope")
+	}
+
+	helper, err := patch.NewHelper(params.YandexMachinePool, params.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init patch helper")
+	}
+	return &MachinePoolScope{
+		client:      params.Client,
+		patchHelper: helper,
+
+		ClusterGetter:     params.ClusterGetter,
+		MachinePool:       params.MachinePool,
+		YandexMachinePool: params.YandexMachinePool,
+	}, nil
+}
+
+// MachinePoolScope defines a scope defined around a machine pool and its cluster.
+type MachinePoolScope struct {
+	client      client.Client
+	patchHelper *patch.Helper
+
+	ClusterGetter     cloud.ClusterGetter
+	MachinePool       *expclusterv1.MachinePool
+	YandexMachinePool *infrav1.YandexMachinePool
+}
+
+// PatchObject persists the machine pool configuration and status.
+func (m *MachinePoolScope) PatchObject() error {
+	return m.patchHelper.Patch(context.TODO(), m.YandexMachinePool)
+}
+
+// Close closes the current scope persisting the machine pool configuration and status.
+func (m *MachinePoolScope) Close() error {
+	return m.PatchObject()
+}
+
+// Name returns the YandexMachinePool name.
+func (m *MachinePoolScope) Name() string {
+	return m.YandexMachinePool.Name
+}
+
+// Namespace returns the namespace name.
+func (m *MachinePoolScope) Namespace() string {
+	return m.YandexMachinePool.Namespace
+}
+
+// GetClient gets client for YandexCloud api.
+func (m *MachinePoolScope) GetClient() yandex.Client {
+	return m.ClusterGetter.GetClient()
+}
+
+// GetInstanceGroupName returns the name of the YandexCloud instance group.
+func (m *MachinePoolScope) GetInstanceGroupName() string {
+	return m.YandexMachinePool.Name
+}
+
+// SetReady sets the YandexMachinePool Ready Status.
+func (m *MachinePoolScope) SetReady(ready bool) {
+	m.YandexMachinePool.Status.Ready = ready
+}
+
+// SetReplicas sets the number of running instance group instances.
+func (m *MachinePoolScope) SetReplicas(replicas int32) {
+	m.YandexMachinePool.Status.Replicas = replicas
+}
+
+// SetInstanceGroupStatus sets the YandexMachinePool instance group status.
+func (m *MachinePoolScope) SetInstanceGroupStatus(v string) {
+	m.YandexMachinePool.Status.InstanceGroupStatus = v
+}
+
+// SetProviderID sets the YandexMachinePool providerID in spec from the instance group ID.
+func (m *MachinePoolScope) SetProviderID(instanceGroupID string) {
+	m.YandexMachinePool.Spec.ProviderID = fmt.Sprintf("%s%s", ProviderIDPrefix, instanceGroupID)
+}
+
+// SetProviderIDList sets the YandexMachinePool providerIDList in spec from the instance IDs.
+func (m *MachinePoolScope) SetProviderIDList(instanceIDs []string) {
+	providerIDList := make([]string, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		providerIDList = append(providerIDList, fmt.Sprintf("%s%s", ProviderIDPrefix, id))
+	}
+	m.YandexMachinePool.Spec.ProviderIDList = providerIDList
+}
+
+// SetFailureMessage sets the YandexMachinePool status failure message.
+func (m *MachinePoolScope) SetFailureMessage(v error) {
+	m.YandexMachinePool.Status.FailureMessage = ptr.To(v.Error())
+}
+
+// SetFailureReason sets the YandexMachinePool status failure reason.
+func (m *MachinePoolScope) SetFailureReason(v capierrors.MachineStatusError) {
+	m.YandexMachinePool.Status.FailureReason = &v
+}
+
+// GetReplicas returns the desired number of the MachinePool replicas.
+func (m *MachinePoolScope) GetReplicas() int64 {
+	if m.MachinePool.Spec.Replicas == nil {
+		return 1
+	}
+	return int64(*m.MachinePool.Spec.Replicas)
+}
+
+// HasBootstrapData returns true if the bootstrap data secret of the MachinePool is set.
+func (m *MachinePoolScope) HasBootstrapData() bool {
+	return m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName != nil
+}
+
+// GetBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+func (m *MachinePoolScope) GetBootstrapData() (string, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: *m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName}
+	if err := m.client.Get(context.TODO(), key, secret); err != nil {
+		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for YandexMachinePool %s/%s", m.Namespace(), m.Name())
+	}
+
+	data, format, err := getBootstrapSecretData(secret)
+	if err != nil {
+		return "", err
+	}
+	if err := checkBootstrapFormat(m.YandexMachinePool.Spec.Template.BootstrapFormat, format); err != nil {
+		return "", err
+	}
+
+	return data, nil
+}
+
+// GetZoneIDs returns the YandexCloud availability zones of the instance group.
+// The zones from the YandexMachinePool specification take precedence over the MachinePool failure domains,
+// which in turn take precedence over the zone of the instance template.
+func (m *MachinePoolScope) GetZoneIDs() []string {
+	if len(m.YandexMachinePool.Spec.ZoneIDs) > 0 {
+		return m.YandexMachinePool.Spec.ZoneIDs
+	}
+
+	if len(m.MachinePool.Spec.FailureDomains) > 0 {
+		return m.MachinePool.Spec.FailureDomains
+	}
+
+	if m.YandexMachinePool.Spec.Template.ZoneID != nil && *m.YandexMachinePool.Spec.Template.ZoneID != "" {
+		return []string{*m.YandexMachinePool.Spec.Template.ZoneID}
+	}
+
+	return []string{defaultZoneID}
+}
+
+// GetSubnetIDs returns the subnet IDs of the instance template network interface.
+// If the network interface does not set the subnet, the subnets of the failure domains
+// of all instance group zones are returned.
+func (m *MachinePoolScope) GetSubnetIDs(networkInterface infrav1.NetworkInterface) ([]string, error) {
+	if networkInterface.SubnetID != "" {
+		return []string{networkInterface.SubnetID}, nil
+	}
+
+	subnetIDs := make([]string, 0)
+	for _, zoneID := range m.GetZoneIDs() {
+		subnetID := m.ClusterGetter.GetFailureDomainSubnetID(zoneID)
+		if subnetID == "" {
+			return nil, fmt.Errorf("no failure domain subnet found for the zone %s", zoneID)
+		}
+		subnetIDs = append(subnetIDs, subnetID)
+	}
+
+	return subnetIDs, nil
+}
+
+// GetInstanceGroupReq returns YandexCloud instance group creation request.
+func (m *MachinePoolScope) GetInstanceGroupReq() (*instancegroup.CreateInstanceGroupRequest, error) {
+	template, err := m.getInstanceTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	allocationPolicy := &instancegroup.AllocationPolicy{}
+	for _, zoneID := range m.GetZoneIDs() {
+		allocationPolicy.Zones = append(allocationPolicy.Zones, &instancegroup.AllocationPolicy_Zone{ZoneId: zoneID})
+	}
+
+	templateHash, err := m.getTemplateHash(template, allocationPolicy)
+	if err != nil {
+		return nil, err
+	}
+	labels := m.getMachinePoolLabels()
+	labels[templateHashLabel] = templateHash
+	labels[bootstrapHashLabel] = getYaAnalyticsLabelHashValue(template.GetMetadata()[infrav1.MetadataKeyUserData])
+
+	return &instancegroup.CreateInstanceGroupRequest{
+		FolderId:         m.ClusterGetter.GetFolderID(),
+		Name:             m.GetInstanceGroupName(),
+		Labels:           labels,
+		InstanceTemplate: template,
+		ScalePolicy: &instancegroup.ScalePolicy{
+			ScaleType: &instancegroup.ScalePolicy_FixedScale_{
+				FixedScale: &instancegroup.ScalePolicy_FixedScale{
+					Size: m.GetReplicas(),
+				},
+			},
+		},
+		DeployPolicy: &instancegroup.DeployPolicy{
+			MaxUnavailable: m.YandexMachinePool.Spec.DeployPolicy.MaxUnavailable,
+			MaxExpansion:   m.YandexMachinePool.Spec.DeployPolicy.MaxExpansion,
+			Strategy:       instancegroup.DeployPolicy_PROACTIVE,
+		},
+		AllocationPolicy: allocationPolicy,
+		ServiceAccountId: m.YandexMachinePool.Spec.ServiceAccountID,
+	}, nil
+}
+
+// IsInstanceGroupUpToDate returns true if the instance group matches the creation request
+// by the number of instances and the instance template hash.
+func (m *MachinePoolScope) IsInstanceGroupUpToDate(ig *instancegroup.InstanceGroup, req *instancegroup.CreateInstanceGroupRequest) bool {
+	return ig.GetScalePolicy().GetFixedScale().GetSize() == req.GetScalePolicy().GetFixedScale().GetSize() &&
+		ig.GetLabels()[templateHashLabel] == req.GetLabels()[templateHashLabel]
+}
+
+// IsInstanceGroupBootstrapDataUpToDate returns true if the instance group template has
+// the bootstrap data of the creation request.
+func (m *MachinePoolScope) IsInstanceGroupBootstrapDataUpToDate(ig *instancegroup.InstanceGroup,
+	req *instancegroup.CreateInstanceGroupRequest) bool {
+	return ig.GetLabels()[bootstrapHashLabel] == req.GetLabels()[bootstrapHashLabel]
+}
+
+// getInstanceTemplate returns the instance group instance template built from the YandexMachinePool template.
+func (m *MachinePoolScope) getInstanceTemplate() (*instancegroup.InstanceTemplate, error) {
+	spec := m.YandexMachinePool.Spec.Template
+
+	bootstrapData, err := m.GetBootstrapData()
+	if err != nil {
+		return nil, err
+	}
+
+	memory, ok := spec.Resources.Memory.AsInt64()
+	if !ok {
+		return nil, errors.New("failed to parse instance's memory from yandex machine pool specification")
+	}
+	resourcesSpec := &instancegroup.ResourcesSpec{
+		Cores:  spec.Resources.Cores,
+		Memory: memory,
+	}
+	if spec.Resources.GPUs != nil {
+		resourcesSpec.Gpus = *spec.Resources.GPUs
+	}
+	if spec.Resources.CoreFraction != nil {
+		resourcesSpec.CoreFraction = *spec.Resources.CoreFraction
+	}
+
+	networkInterfacesSpecs := make([]*instancegroup.NetworkInterfaceSpec, 0)
+	for i, networkInterface := range spec.NetworkInterfaces {
+		subnetIDs, err := m.GetSubnetIDs(networkInterface)
+		if err != nil {
+			return nil, fmt.Errorf("subnet for the network interface %d is not set: %w", i, err)
+		}
+
+		networkInterfaceSpec := &instancegroup.NetworkInterfaceSpec{
+			NetworkId:            m.ClusterGetter.GetNetworkID(),
+			SubnetIds:            subnetIDs,
+			PrimaryV4AddressSpec: &instancegroup.PrimaryAddressSpec{},
+			SecurityGroupIds: getSecurityGroupIDs(
+				spec.NetworkInterfaces, i, m.ClusterGetter.GetNodeSecurityGroupID()),
+		}
+		if networkInterface.HasPublicIP != nil && *networkInterface.HasPublicIP {
+			networkInterfaceSpec.PrimaryV4AddressSpec = &instancegroup.PrimaryAddressSpec{
+				OneToOneNatSpec: &instancegroup.OneToOneNatSpec{
+					IpVersion: instancegroup.IpVersion_IPV4,
+				},
+			}
+		}
+		if hasIPv6(networkInterface) {
+			networkInterfaceSpec.PrimaryV6AddressSpec = &instancegroup.PrimaryAddressSpec{}
+		}
+		networkInterfacesSpecs = append(networkInterfacesSpecs, networkInterfaceSpec)
+	}
+
+	bootDiskSize, ok := spec.BootDisk.Size.AsInt64()
+	if !ok {
+		return nil, errors.New("failed to parse instance's boot disk size from yandex machine pool specification")
+	}
+
+	secondaryDiskSpecs := make([]*instancegroup.AttachedDiskSpec, 0, len(spec.SecondaryDisks))
+	for i, disk := range spec.SecondaryDisks {
+		size, ok := disk.Size.AsInt64()
+		if !ok {
+			return nil, fmt.Errorf("failed to parse secondary disk %d size from yandex machine pool specification", i)
+		}
+
+		diskSpec := &instancegroup.AttachedDiskSpec_DiskSpec{
+			TypeId: ptr.Deref(disk.TypeID, ""),
+			Size:   size,
+		}
+		switch {
+		case disk.ImageID != "":
+			diskSpec.SourceOneof = &instancegroup.AttachedDiskSpec_DiskSpec_ImageId{ImageId: disk.ImageID}
+		case disk.SnapshotID != "":
+			diskSpec.SourceOneof = &instancegroup.AttachedDiskSpec_DiskSpec_SnapshotId{SnapshotId: disk.SnapshotID}
+		}
+
+		secondaryDiskSpecs = append(secondaryDiskSpecs, &instancegroup.AttachedDiskSpec{
+			Mode:       instancegroup.AttachedDiskSpec_READ_WRITE,
+			DeviceName: disk.DeviceName,
+			DiskSpec:   diskSpec,
+		})
+	}
+
+	metadata, err := getInstanceMetadata(context.TODO(), m.client, m.Namespace(), &spec, bootstrapData)
+	if err != nil {
+		return nil, err
+	}
+
+	// Instance names must be unique in the folder, so they are generated from the group name
+	// and the instance short ID, see https://yandex.cloud/ru/docs/compute/concepts/instance-groups/variables-in-the-template .
+	instanceName := fmt.Sprintf("%s-{instance.short_id}", m.GetInstanceGroupName())
+
+	template := &instancegroup.InstanceTemplate{
+		Name:             instanceName,
+		Hostname:         instanceName,
+		PlatformId:       *spec.PlatformID,
+		Metadata:         metadata,
+		Labels:           m.getMachinePoolLabels(),
+		ServiceAccountId: spec.ServiceAccountID,
+		ResourcesSpec:    resourcesSpec,
+		BootDiskSpec: &instancegroup.AttachedDiskSpec{
+			Mode: instancegroup.AttachedDiskSpec_READ_WRITE,
+			DiskSpec: &instancegroup.AttachedDiskSpec_DiskSpec{
+				TypeId: *spec.BootDisk.TypeID,
+				Size:   bootDiskSize,
+				SourceOneof: &instancegroup.AttachedDiskSpec_DiskSpec_ImageId{
+					ImageId: spec.BootDisk.ImageID,
+				},
+			},
+		},
+		SecondaryDiskSpecs:    secondaryDiskSpecs,
+		NetworkInterfaceSpecs: networkInterfacesSpecs,
+	}
+	if ptr.Deref(spec.Preemptible, false) {
+		template.SchedulingPolicy = &instancegroup.SchedulingPolicy{Preemptible: true}
+	}
+	if spec.PlacementGroupID != "" {
+		template.PlacementPolicy = &instancegroup.PlacementPolicy{PlacementGroupId: spec.PlacementGroupID}
+	}
+
+	return template, nil
+}
+
+// getTemplateHash returns the hash of the instance template and allocation policy,
+// which is used to detect the changes to be rolled out to the instance group.
+// The bootstrap data is changed on every join token refresh, so it is hashed separately
+// to deliver the new bootstrap data to the new instances without rolling the running ones.
+func (m *MachinePoolScope) getTemplateHash(template *instancegroup.InstanceTemplate,
+	allocationPolicy *instancegroup.AllocationPolicy) (string, error) {
+	hashedTemplate := proto.Clone(template).(*instancegroup.InstanceTemplate)
+	delete(hashedTemplate.Metadata, infrav1.MetadataKeyUserData)
+
+	marshal := proto.MarshalOptions{Deterministic: true}
+	templateData, err := marshal.Marshal(hashedTemplate)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal instance template")
+	}
+	allocationPolicyData, err := marshal.Marshal(allocationPolicy)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal allocation policy")
+	}
+	deployPolicyData, err := json.Marshal(m.YandexMachinePool.Spec.DeployPolicy)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal deploy policy")
+	}
+
+	// The parts are prefixed with their lengths, so the different parts could not produce the same data.
+	var data strings.Builder
+	for _, part := range [][]byte{templateData, allocationPolicyData, deployPolicyData} {
+		fmt.Fprintf(&data, "%d:%s", len(part), part)
+	}
+
+	return getYaAnalyticsLabelHashValue(data.String()), nil
+}
+
+// GetInstanceGroupID returns the instance group ID by parsing the scope's providerID.
+func (m *MachinePoolScope) GetInstanceGroupID() string {
+	return parseProviderID(m.YandexMachinePool.Spec.ProviderID)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMachinePoolScope_GetZoneIDs(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name        string
+		machinePool *expclusterv1.MachinePool
+		ymp         *infrav1.YandexMachinePool
+		want        []string
+	}{
+		{
+			name:        "Zones from the YandexMachinePool specification take precedence",
+			machinePool: &expclusterv1.MachinePool{Spec: expclusterv1.MachinePoolSpec{FailureDomains: []string{"ru-central1-a"}}},
+			ymp: &infrav1.YandexMachinePool{Spec: infrav1.YandexMachinePoolSpec{
+				ZoneIDs: []string{"ru-central1-b", "ru-central1-d"},
+			}},
+			want: []string{"ru-central1-b", "ru-central1-d"},
+		},
+		{
+			name:        "Zones are taken from the MachinePool failure domains",
+			machinePool: &expclusterv1.MachinePool{Spec: expclusterv1.MachinePoolSpec{FailureDomains: []string{"ru-central1-a"}}},
+			ymp: &infrav1.YandexMachinePool{Spec: infrav1.YandexMachinePoolSpec{
+				Template: infrav1.YandexMachineSpec{ZoneID: ptr.To("ru-central1-b")},
+			}},
+			want: []string{"ru-central1-a"},
+		},
+		{
+			name:        "Zone is taken from the instance template",
+			machinePool: &expclusterv1.MachinePool{},
+			ymp: &infrav1.YandexMachinePool{Spec: infrav1.YandexMachinePoolSpec{
+				Template: infrav1.YandexMachineSpec{ZoneID: ptr.To("ru-central1-b")},
+			}},
+			want: []string{"ru-central1-b"},
+		},
+		{
+			name:        "Default zone is used if nothing is set",
+			machinePool: &expclusterv1.MachinePool{},
+			ymp:         &infrav1.YandexMachinePool{},
+			want:        []string{"ru-central1-d"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			scp := scope.MachinePoolScope{MachinePool: test.machinePool, YandexMachinePool: test.ymp}
+			g.Expect(scp.GetZoneIDs()).To(Equal(test.want))
+		})
+	}
+}
+
+func TestMachinePoolScope_ProviderID(t *testing.T) {
+	g := NewWithT(t)
+
+	scp := scope.MachinePoolScope{YandexMachinePool: &infrav1.YandexMachinePool{}}
+
+	t.Run("SetProviderID should set the instance group ID in the required format", func(_ *testing.T) {
+		scp.SetProviderID("instance-group")
+		g.Expect(scp.YandexMachinePool.Spec.ProviderID).To(Equal("yandex://instance-group"))
+		g.Expect(scp.GetInstanceGroupID()).To(Equal("instance-group"))
+	})
+
+	t.Run("SetProviderIDList should set the instance IDs in the required format", func(_ *testing.T) {
+		scp.SetProviderIDList([]string{"instance-a", "instance-b"})
+		g.Expect(scp.YandexMachinePool.Spec.ProviderIDList).To(Equal([]string{"yandex://instance-a", "yandex://instance-b"}))
+	})
+}
+
+func TestMachinePoolScope_GetInstanceGroupReqTemplateHash(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+
+	secret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "test-secret"},
+		Data:       map[string][]byte{"value": []byte("bootstrap-data")},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	mp := &expclusterv1.MachinePool{ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "mp-test"}}
+	mp.Spec.ClusterName = "cluster"
+	mp.Spec.Template.Spec.Bootstrap.DataSecretName = ptr.To(secret.Name)
+	ymp := &infrav1.YandexMachinePool{
+		ObjectMeta: v1.ObjectMeta{Namespace: "test", Name: "ymp-test"},
+		Spec: infrav1.YandexMachinePoolSpec{
+			Template: infrav1.YandexMachineSpec{
+				PlatformID: ptr.To("standard-v3"),
+				BootDisk: &infrav1.Disk{
+					TypeID:  ptr.To("network-ssd"),
+					Size:    resource.MustParse("20Gi"),
+					ImageID: "image-id",
+				},
+				Resources: infrav1.Resources{
+					Memory: resource.MustParse("4Gi"),
+					Cores:  2,
+				},
+				NetworkInterfaces: []infrav1.NetworkInterface{{SubnetID: "subnet-id"}},
+				Metadata:          map[string]string{"serial-port-enable": "1", "enable-oslogin": "true"},
+			},
+			ZoneIDs: []string{"ru-central1-a", "ru-central1-b"},
+		},
+	}
+	scp, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
+		Client:            k8sClient,
+		ClusterGetter:     &scope.ClusterScope{YandexCluster: &infrav1.YandexCluster{}},
+		MachinePool:       mp,
+		YandexMachinePool: ymp,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	getHash := func() string {
+		req, err := scp.GetInstanceGroupReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		return req.GetLabels()["yandex.cloud/capy-template-hash"]
+	}
+	hash := getHash()
+	g.Expect(hash).NotTo(BeEmpty())
+
+	t.Run("template hash should be stable", func(_ *testing.T) {
+		for i := 0; i < 10; i++ {
+			g.Expect(getHash()).To(Equal(hash))
+		}
+	})
+
+	t.Run("template hash should not change with bootstrap data", func(_ *testing.T) {
+		req, err := scp.GetInstanceGroupReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		bootstrapHash := req.GetLabels()["yandex.cloud/capy-bootstrap-hash"]
+		g.Expect(bootstrapHash).NotTo(BeEmpty())
+
+		secret.Data["value"] = []byte("refreshed-bootstrap-data")
+		g.Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+		g.Expect(getHash()).To(Equal(hash))
+
+		req, err = scp.GetInstanceGroupReq()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(req.GetLabels()["yandex.cloud/capy-bootstrap-hash"]).NotTo(Equal(bootstrapHash))
+	})
+
+	t.Run("template hash should change with instance template", func(_ *testing.T) {
+		ymp.Spec.Template.Resources.Cores = 4
+		g.Expect(getHash()).NotTo(Equal(hash))
+	})
+}
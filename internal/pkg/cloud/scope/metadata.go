@@ -0,0 +1,67 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getInstanceMetadata returns the YandexCloud VM metadata built from the machine specification and the bootstrap data.
+// The reserved metadata keys are always set by the provider, so they take precedence over the specification metadata.
+func getInstanceMetadata(
+	ctx context.Context, c client.Client, namespace string, spec *infrav1.YandexMachineSpec, bootstrapData string,
+) (map[string]string, error) {
+	metadata := make(map[string]string, len(spec.Metadata)+2)
+	for key, value := range spec.Metadata {
+		metadata[key] = value
+	}
+
+	if len(spec.SSHKeys) > 0 {
+		sshKeys, err := getSSHKeys(ctx, c, namespace, spec.SSHKeys)
+		if err != nil {
+			return nil, err
+		}
+		metadata[infrav1.MetadataKeySSHKeys] = sshKeys
+	} else {
+		delete(metadata, infrav1.MetadataKeySSHKeys)
+	}
+	metadata[infrav1.MetadataKeyUserData] = bootstrapData
+
+	return metadata, nil
+}
+
+// getSSHKeys returns the ssh-keys metadata value, which contains a "<user>:<public key>" line per SSH key.
+func getSSHKeys(ctx context.Context, c client.Client, namespace string, keys []infrav1.SSHKey) (string, error) {
+	lines := make([]string, 0, len(keys))
+	for i, key := range keys {
+		publicKey := key.PublicKey
+		if ref := key.PublicKeySecretRef; ref != nil {
+			secret := &corev1.Secret{}
+			secretKey := types.NamespacedName{Namespace: namespace, Name: ref.Name}
+			if err := c.Get(ctx, secretKey, secret); err != nil {
+				return "", fmt.Errorf("failed to retrieve SSH key %d secret %s: %w", i, secretKey, err)
+			}
+
+			value, ok := secret.Data[ref.Key]
+			if !ok {
+				return "", fmt.Errorf("SSH key %d secret %s has no key %s", i, secretKey, ref.Key)
+			}
+			publicKey = string(value)
+		}
+
+		// Every line of ssh-keys authorizes a key for a user, so a multi-line key could add keys for other users.
+		publicKey = strings.TrimSpace(publicKey)
+		if strings.ContainsAny(publicKey, "\n\r") {
+			return "", fmt.Errorf("SSH key %d of user %s must be a single line", i, key.User)
+		}
+
+		lines = append(lines, fmt.Sprintf("%s:%s", key.User, publicKey))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,59 @@
+package scope
+
+import (
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// IsInPlaceResizeAllowed returns true if the YandexMachine platform and resources could be changed in place.
+func (m *MachineScope) IsInPlaceResizeAllowed() bool {
+	return m.YandexMachine.GetAnnotations()[infrav1.InPlaceResizeAnnotation] == "true"
+}
+
+// IsInstanceResizing returns true if the in-place resize of the instance has been started and is not finished yet.
+func (m *MachineScope) IsInstanceResizing() bool {
+	if !conditions.IsFalse(m.YandexMachine, infrav1.InstanceResizedCondition) {
+		return false
+	}
+
+	switch conditions.GetReason(m.YandexMachine, infrav1.InstanceResizedCondition) {
+	case infrav1.InstanceStoppingForResizeReason,
+		infrav1.InstanceUpdatingResourcesReason,
+		infrav1.InstanceStartingAfterResizeReason:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetInstanceResizeReq returns the request to update the instance platform and resources
+// from YandexMachine specification, or nil if the instance already matches the specification.
+func (m *MachineScope) GetInstanceResizeReq(vm *compute.Instance) (*compute.UpdateInstanceRequest, error) {
+	resourcesSpec, err := m.getResourcesSpec()
+	if err != nil {
+		return nil, err
+	}
+	if resourcesSpec.CoreFraction == 0 {
+		resourcesSpec.CoreFraction = defaultCoreFraction
+	}
+	platformID := ptr.Deref(m.YandexMachine.Spec.PlatformID, "")
+
+	resources := vm.GetResources()
+	if platformID == vm.GetPlatformId() &&
+		resourcesSpec.GetCores() == resources.GetCores() &&
+		resourcesSpec.GetMemory() == resources.GetMemory() &&
+		resourcesSpec.GetCoreFraction() == resources.GetCoreFraction() &&
+		resourcesSpec.GetGpus() == resources.GetGpus() {
+		return nil, nil
+	}
+
+	return &compute.UpdateInstanceRequest{
+		InstanceId:    vm.GetId(),
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"platform_id", "resources_spec"}},
+		PlatformId:    platformID,
+		ResourcesSpec: resourcesSpec,
+	}, nil
+}
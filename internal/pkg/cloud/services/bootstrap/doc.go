@@ -0,0 +1,2 @@
+// Package bootstrap has all services to deliver the machine bootstrap data through the object storage.
+package bootstrap
@@ -0,0 +1,67 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	resourceDeleted    = true
+	resourceNotDeleted = false
+)
+
+// Reconcile uploads the bootstrap data to the object storage before the instance is created
+// and points the instance user-data to the presigned object URL.
+// The object is deleted once the node has joined the cluster, as the bootstrap data is not needed anymore.
+func (s *Service) Reconcile(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	if s.scope.GetInstanceID() != "" {
+		if s.scope.GetBootstrapDataObject() == "" || !s.scope.IsNodeJoined() {
+			return nil
+		}
+
+		if _, err := s.Delete(ctx); err != nil {
+			return err
+		}
+		logger.Info("bootstrap data object deleted, node has joined the cluster")
+		return nil
+	}
+
+	data, err := s.scope.GetBootstrapData()
+	if err != nil {
+		return err
+	}
+
+	key := s.scope.GetBootstrapDataObjectKey()
+	if err := s.storage.PutObject(ctx, key, []byte(data)); err != nil {
+		return fmt.Errorf("failed to upload bootstrap data: %w", err)
+	}
+	s.scope.SetBootstrapDataObject(key)
+
+	url, err := s.storage.PresignGetObject(key)
+	if err != nil {
+		return fmt.Errorf("failed to presign bootstrap data object: %w", err)
+	}
+	s.scope.SetBootstrapDataURL(url)
+
+	logger.V(1).Info("bootstrap data uploaded", "object", key)
+	return nil
+}
+
+// Delete deletes the bootstrap data object from the object storage.
+func (s *Service) Delete(ctx context.Context) (bool, error) {
+	key := s.scope.GetBootstrapDataObject()
+	if key == "" {
+		return resourceDeleted, nil
+	}
+
+	if err := s.storage.DeleteObject(ctx, key); err != nil {
+		return resourceNotDeleted, fmt.Errorf("failed to delete bootstrap data object: %w", err)
+	}
+
+	s.scope.SetBootstrapDataObject("")
+	return resourceDeleted, nil
+}
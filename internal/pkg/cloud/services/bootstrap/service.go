@@ -0,0 +1,23 @@
+package bootstrap
+
+import (
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/storage"
+)
+
+// Service implements bootstrap data object reconciler.
+type Service struct {
+	scope   *scope.MachineScope
+	storage storage.Client
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns a new bootstrap data service given the object storage client.
+func New(scp *scope.MachineScope, storageClient storage.Client) *Service {
+	return &Service{
+		scope:   scp,
+		storage: storageClient,
+	}
+}
@@ -0,0 +1,85 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+	yandex_compute "github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileSpecDrift compares the running instance with the YandexMachine specification
+// and reports the differences in the SpecInSync condition.
+// The drifted labels and metadata are restored in place, if the YandexMachine allows it.
+func (s *Service) reconcileSpecDrift(ctx context.Context, client yandex.Client, vm *yandex_compute.Instance) error {
+	logger := log.FromContext(ctx)
+
+	var bootDisk *yandex_compute.Disk
+	if diskID := vm.GetBootDisk().GetDiskId(); diskID != "" {
+		disk, err := client.DiskGet(ctx, diskID)
+		if err != nil {
+			return fmt.Errorf("failed to get compute instance boot disk %s: %w", diskID, err)
+		}
+		bootDisk = disk
+	}
+
+	drift, err := s.scope.GetInstanceDrift(ctx, vm, bootDisk)
+	if err != nil {
+		return fmt.Errorf("failed to compare compute instance with specification: %w", err)
+	}
+
+	details := drift.Immutable
+	switch {
+	case len(drift.Mutable) == 0:
+	case s.scope.YandexMachine.Spec.RestoreDrift:
+		if err := s.restoreSpecDrift(ctx, client, drift); err != nil {
+			return err
+		}
+		logger.Info("compute instance labels and metadata restored", "drift", drift.Mutable)
+	default:
+		details = append(details, drift.Mutable...)
+	}
+
+	if len(details) == 0 {
+		conditions.MarkTrue(s.scope.YandexMachine, infrav1.InstanceSpecInSyncCondition)
+		return nil
+	}
+
+	logger.Info("compute instance differs from specification", "drift", details)
+	conditions.MarkFalse(s.scope.YandexMachine,
+		infrav1.InstanceSpecInSyncCondition,
+		infrav1.InstanceSpecDriftedReason,
+		clusterv1.ConditionSeverityWarning,
+		"%s", strings.Join(details, "; "))
+	return nil
+}
+
+// restoreSpecDrift re-applies the drifted labels and metadata to the instance.
+func (s *Service) restoreSpecDrift(ctx context.Context, client yandex.Client, drift *scope.InstanceDrift) error {
+	instanceID := s.scope.GetInstanceID()
+	if drift.Labels != nil {
+		err := client.ComputeUpdate(ctx, &yandex_compute.UpdateInstanceRequest{
+			InstanceId: instanceID,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+			Labels:     drift.Labels,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to restore compute instance %s labels: %w", instanceID, err)
+		}
+	}
+
+	if drift.Metadata != nil {
+		if err := client.ComputeUpdateMetadata(ctx, instanceID, drift.Metadata, nil); err != nil {
+			return fmt.Errorf("failed to restore compute instance %s metadata: %w", instanceID, err)
+		}
+	}
+
+	return nil
+}
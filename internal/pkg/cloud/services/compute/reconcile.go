@@ -10,6 +10,7 @@ import (
 	yandex_compute "github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
 	corev1 "k8s.io/api/core/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -70,6 +71,17 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return fmt.Errorf("unable to find compute instance %v: %w", s.scope.GetInstanceID(), err)
 	}
 
+	s.scope.SetSecondaryDisks(getInstanceSecondaryDisks(vm))
+
+	resizing, err := s.reconcileResize(ctx, client, vm)
+	if err != nil {
+		return err
+	}
+	if resizing {
+		s.scope.SetInstanceStatus(infrav1.InstanceStatus(vm.GetStatus().String()))
+		return nil
+	}
+
 	instanceState := infrav1.InstanceStatus(vm.GetStatus().String())
 	if instanceState == infrav1.InstanceStatusRunning {
 		instanceAddress, err := s.getInstanceAddress(vm)
@@ -91,6 +103,28 @@ func (s *Service) Reconcile(ctx context.Context) error {
 			}
 		}
 		conditions.MarkTrue(s.scope.YandexMachine, infrav1.ConditionStatusRunning)
+
+		if err := s.purgeBootstrapData(ctx, client); err != nil {
+			return err
+		}
+
+		if err := s.reconcileSpecDrift(ctx, client, vm); err != nil {
+			return err
+		}
+	}
+
+	// Preemptible instance is stopped by Compute when preempted and never starts by itself,
+	// so the machine is marked as failed to be replaced by MachineHealthCheck.
+	if instanceState == infrav1.InstanceStatusStopped && s.scope.IsPreemptible() {
+		logger.Info("preemptible compute instance is stopped, marking machine as failed", "instance-id", s.scope.GetInstanceID())
+		s.scope.SetNotReady()
+		s.scope.SetFailureReason(capierrors.UpdateMachineError)
+		s.scope.SetFailureMessage(fmt.Errorf("preemptible compute instance %s has been preempted", s.scope.GetInstanceID()))
+		conditions.MarkFalse(s.scope.YandexMachine,
+			infrav1.ConditionStatusRunning,
+			infrav1.InstancePreemptedReason,
+			clusterv1.ConditionSeverityError,
+			"preemptible compute instance %s has been stopped", s.scope.GetInstanceID())
 	}
 
 	s.scope.SetInstanceStatus(infrav1.InstanceStatus(vm.GetStatus().String()))
@@ -103,7 +137,6 @@ func (s *Service) Delete(ctx context.Context) (bool, error) {
 	logger.Info("deleting YandexMachine compute instance")
 	client := s.scope.GetClient()
 
-	// TODO: bootstap secret deletion handling.
 	instanceID := s.scope.GetInstanceID()
 	// instance never been created, mark deleted and return.
 	if instanceID == "" {
@@ -149,57 +182,182 @@ func (s *Service) Delete(ctx context.Context) (bool, error) {
 	return instanceNotDeleted, client.ComputeDelete(ctx, instanceID)
 }
 
+// purgeBootstrapData removes the bootstrap data from the metadata of the running instance,
+// once the node has joined the cluster, so the credentials like join tokens
+// do not stay on the metadata service for the whole instance life.
+func (s *Service) purgeBootstrapData(ctx context.Context, client yandex.Client) error {
+	if conditions.IsTrue(s.scope.YandexMachine, infrav1.BootstrapDataPurgedCondition) {
+		return nil
+	}
+
+	if !s.scope.IsNodeJoined() {
+		conditions.MarkFalse(s.scope.YandexMachine,
+			infrav1.BootstrapDataPurgedCondition,
+			infrav1.WaitingForNodeReason,
+			clusterv1.ConditionSeverityInfo, "")
+		return nil
+	}
+
+	err := client.ComputeUpdateMetadata(ctx, s.scope.GetInstanceID(), nil, []string{infrav1.MetadataKeyUserData})
+	if err != nil {
+		conditions.MarkFalse(s.scope.YandexMachine,
+			infrav1.BootstrapDataPurgedCondition,
+			infrav1.BootstrapDataPurgeFailedReason,
+			clusterv1.ConditionSeverityWarning,
+			"%s", err.Error())
+		return fmt.Errorf("failed to remove bootstrap data from compute instance %s metadata: %w",
+			s.scope.GetInstanceID(), err)
+	}
+
+	log.FromContext(ctx).Info("bootstrap data removed from compute instance metadata",
+		"instance-id", s.scope.GetInstanceID())
+	conditions.MarkTrue(s.scope.YandexMachine, infrav1.BootstrapDataPurgedCondition)
+	return nil
+}
+
 // createComputeInstance creates a virtual machine from YandexCompute specification.
 func (s *Service) createComputeInstance(ctx context.Context, client yandex.Client) (string, error) {
+	if err := s.resolveBootImage(ctx, client); err != nil {
+		return "", err
+	}
+
 	request, err := s.scope.GetInstanceReq()
 	if err != nil {
 		return "", err
 	}
+
+	if err := s.setPublicAddresses(ctx, client, request); err != nil {
+		return "", err
+	}
 	return client.ComputeCreate(ctx, request)
 }
 
-// getInstanceAddress returns the internal IP address of the instance.
+// setPublicAddresses sets the reserved public addresses of the network interfaces to the instance creation request.
+func (s *Service) setPublicAddresses(
+	ctx context.Context, client yandex.Client, request *yandex_compute.CreateInstanceRequest,
+) error {
+	for i, networkInterface := range s.scope.YandexMachine.Spec.NetworkInterfaces {
+		if networkInterface.PublicAddressID == "" {
+			continue
+		}
+
+		address, err := client.VPCAddressGet(ctx, networkInterface.PublicAddressID)
+		if err != nil {
+			return fmt.Errorf("failed to get reserved public address %s: %w", networkInterface.PublicAddressID, err)
+		}
+		if address.GetExternalIpv4Address().GetAddress() == "" {
+			return fmt.Errorf("reserved address %s is not a public IPv4 address", networkInterface.PublicAddressID)
+		}
+
+		request.NetworkInterfaceSpecs[i].PrimaryV4AddressSpec.OneToOneNatSpec = &yandex_compute.OneToOneNatSpec{
+			IpVersion: yandex_compute.IpVersion_IPV4,
+			Address:   address.GetExternalIpv4Address().GetAddress(),
+		}
+	}
+
+	return nil
+}
+
+// getInstanceAddress returns the addresses of all instance network interfaces.
+// The internal addresses are listed in the network interfaces order followed by the external addresses.
 func (s *Service) getInstanceAddress(instance *yandex_compute.Instance) ([]corev1.NodeAddress, error) {
 	intfList := instance.GetNetworkInterfaces()
-	// TODO: MVP support only one interface with IPv4 Address.
-	if len(intfList) != 1 {
-		return nil, fmt.Errorf("only one interface supported")
+	if len(intfList) == 0 {
+		return nil, fmt.Errorf("instance has no network interfaces")
 	}
 
-	address := intfList[0].GetPrimaryV4Address().GetAddress()
-	if address == "" {
-		return nil, fmt.Errorf("only IPv4 address supported")
+	// The internal IPv4 addresses go first, then the internal IPv6 addresses of dual-stack interfaces
+	// and the external addresses, all of them in the network interfaces order.
+	var internal, internalV6, external []corev1.NodeAddress
+	for _, intf := range intfList {
+		address := intf.GetPrimaryV4Address().GetAddress()
+		if address == "" {
+			return nil, fmt.Errorf("network interface %s has no IPv4 address", intf.GetIndex())
+		}
+		internal = append(internal, corev1.NodeAddress{
+			Type:    corev1.NodeInternalIP,
+			Address: address,
+		})
+
+		if v6Address := intf.GetPrimaryV6Address().GetAddress(); v6Address != "" {
+			internalV6 = append(internalV6, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: v6Address,
+			})
+		}
+
+		if natAddress := intf.GetPrimaryV4Address().GetOneToOneNat().GetAddress(); natAddress != "" {
+			external = append(external, corev1.NodeAddress{
+				Type:    corev1.NodeExternalIP,
+				Address: natAddress,
+			})
+		}
 	}
 
-	return []corev1.NodeAddress{{
-		Type:    corev1.NodeInternalIP,
-		Address: address,
-	}}, nil
+	return append(append(internal, internalV6...), external...), nil
+}
+
+// resolveBootImage records the boot disk image in the YandexMachine status, so the instance is reproducible.
+// The image family is resolved to the latest image only once, the recorded image is used on the next attempts.
+func (s *Service) resolveBootImage(ctx context.Context, client yandex.Client) error {
+	if imageID := s.scope.GetBootImageID(); imageID != "" {
+		s.scope.SetBootImageID(imageID)
+		return nil
+	}
+
+	folderID, family := s.scope.GetBootImageFamily()
+	if family == "" {
+		return fmt.Errorf("neither boot disk image nor image family is set")
+	}
+
+	image, err := client.ImageGetLatestByFamily(ctx, folderID, family)
+	if err != nil {
+		return fmt.Errorf("failed to get latest image of family %s in folder %s: %w", family, folderID, err)
+	}
+
+	log.FromContext(ctx).Info("boot disk image resolved", "family", family, "image-id", image.GetId())
+	s.scope.SetBootImageID(image.GetId())
+	return nil
+}
+
+// getInstanceSecondaryDisks returns the network and local secondary disks attached to the instance.
+func getInstanceSecondaryDisks(instance *yandex_compute.Instance) []infrav1.DiskStatus {
+	var disks []infrav1.DiskStatus
+	for _, disk := range instance.GetSecondaryDisks() {
+		disks = append(disks, infrav1.DiskStatus{
+			ID:         disk.GetDiskId(),
+			DeviceName: disk.GetDeviceName(),
+		})
+	}
+	for _, disk := range instance.GetLocalDisks() {
+		disks = append(disks, infrav1.DiskStatus{
+			DeviceName: disk.GetDeviceName(),
+			Local:      true,
+		})
+	}
+
+	return disks
 }
 
 // registerControlPlane adds controlplane instance address to controlplane loadbalancer target group.
 func (s *Service) registerControlPlane(ctx context.Context) error {
-	addresses := s.scope.GetAddresses()
-	if len(addresses) == 0 {
+	address, subnetID := s.scope.GetLoadBalancerTarget()
+	if address == "" {
 		return fmt.Errorf("no addresses registered for YandexMachne %s", s.scope.Name())
 	}
 
-	address := addresses[0].Address
-	subnetID := s.scope.YandexMachine.Spec.NetworkInterfaces[0].SubnetID
 	return s.scope.LoadBalancer.AddTarget(ctx, address, subnetID)
 }
 
 // deregisterControlPlane removes controlplane instance address from controlplane loadbalancer target group.
 func (s *Service) deregisterControlPlane(ctx context.Context) error {
 	logger := log.FromContext(ctx)
-	addresses := s.scope.GetAddresses()
+	address, subnetID := s.scope.GetLoadBalancerTarget()
 	// if instance have no addresses, skip deregistration.
-	if len(addresses) == 0 {
+	if address == "" {
 		logger.V(1).Info("no addresses registered for YandexMachne", "name", s.scope.Name())
 		return nil
 	}
 
-	address := addresses[0].Address
-	subnetID := s.scope.YandexMachine.Spec.NetworkInterfaces[0].SubnetID
 	return s.scope.LoadBalancer.RemoveTarget(ctx, address, subnetID)
 }
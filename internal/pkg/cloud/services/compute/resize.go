@@ -0,0 +1,97 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
+	yandex_compute "github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileResize changes the instance platform and resources in place, if the YandexMachine allows it.
+// The instance is stopped, updated and started again, each step is reported in the InstanceResized condition.
+// Returns true while the resize is in progress, so the instance status is not treated as a failure.
+func (s *Service) reconcileResize(ctx context.Context, client yandex.Client, vm *yandex_compute.Instance) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	req, err := s.scope.GetInstanceResizeReq(vm)
+	if err != nil {
+		return false, err
+	}
+
+	// The started resize is finished even if the annotation has been removed, so the instance is not left stopped.
+	resizing := s.scope.IsInstanceResizing()
+	if !resizing && (req == nil || !s.scope.IsInPlaceResizeAllowed()) {
+		return false, nil
+	}
+
+	instanceID := s.scope.GetInstanceID()
+	switch vm.GetStatus() {
+	case yandex_compute.Instance_RUNNING:
+		if req == nil {
+			logger.Info("compute instance resized", "instance-id", instanceID)
+			conditions.MarkTrue(s.scope.YandexMachine, infrav1.InstanceResizedCondition)
+			return false, nil
+		}
+
+		logger.Info("stopping compute instance to resize", "instance-id", instanceID)
+		if err := client.ComputeStop(ctx, instanceID); err != nil {
+			return false, fmt.Errorf("failed to stop compute instance %s for resize: %w", instanceID, err)
+		}
+		s.markResizing(infrav1.InstanceStoppingForResizeReason)
+	case yandex_compute.Instance_STOPPED:
+		if !resizing {
+			return false, nil
+		}
+
+		if req != nil {
+			logger.Info("updating compute instance resources", "instance-id", instanceID,
+				"platform-id", req.GetPlatformId(), "resources", req.GetResourcesSpec().String())
+			s.markResizing(infrav1.InstanceUpdatingResourcesReason)
+			if err := client.ComputeUpdate(ctx, req); err != nil {
+				conditions.MarkFalse(s.scope.YandexMachine,
+					infrav1.InstanceResizedCondition,
+					infrav1.InstanceUpdatingResourcesReason,
+					clusterv1.ConditionSeverityWarning,
+					"%s", err.Error())
+				return true, fmt.Errorf("failed to update compute instance %s resources: %w", instanceID, err)
+			}
+			return true, nil
+		}
+
+		logger.Info("starting compute instance after resize", "instance-id", instanceID)
+		if err := client.ComputeStart(ctx, instanceID); err != nil {
+			return true, fmt.Errorf("failed to start compute instance %s after resize: %w", instanceID, err)
+		}
+		s.markResizing(infrav1.InstanceStartingAfterResizeReason)
+	case yandex_compute.Instance_STOPPING, yandex_compute.Instance_UPDATING, yandex_compute.Instance_STARTING:
+		if !resizing {
+			return false, nil
+		}
+	default:
+		if !resizing {
+			return false, nil
+		}
+
+		conditions.MarkFalse(s.scope.YandexMachine,
+			infrav1.InstanceResizedCondition,
+			infrav1.InstanceResizeFailedReason,
+			clusterv1.ConditionSeverityError,
+			"compute instance %s got into %s state during resize", instanceID, vm.GetStatus())
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// markResizing reports the current step of the in-place resize in the InstanceResized condition.
+func (s *Service) markResizing(reason string) {
+	conditions.MarkFalse(s.scope.YandexMachine,
+		infrav1.InstanceResizedCondition,
+		reason,
+		clusterv1.ConditionSeverityInfo, "")
+}
@@ -0,0 +1,2 @@
+// Package instancegroup has all services and interface to work with the YandexCloud Compute instance groups API.
+package instancegroup
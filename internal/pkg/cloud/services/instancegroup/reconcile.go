@@ -0,0 +1,198 @@
+package instancegroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+)
+
+const (
+	instanceGroupDeleted    bool = true
+	instanceGroupNotDeleted bool = false
+)
+
+// updateMaskPaths are the instance group fields updated when the YandexMachinePool specification changes.
+var updateMaskPaths = []string{
+	"labels",
+	"instance_template",
+	"scale_policy",
+	"deploy_policy",
+	"allocation_policy",
+	"service_account_id",
+}
+
+// bootstrapUpdateMaskPaths are the instance group fields updated when the bootstrap data is refreshed.
+var bootstrapUpdateMaskPaths = []string{
+	"labels",
+	"instance_template",
+	"deploy_policy",
+}
+
+// Reconcile reconciles the instance group and publishes its instances in the YandexMachinePool.
+func (s *Service) Reconcile(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	logger.Info("reconciling instance group")
+
+	client := s.scope.GetClient()
+
+	req, err := s.scope.GetInstanceGroupReq()
+	if err != nil {
+		s.markFailed(err)
+		return err
+	}
+
+	ig, err := client.InstanceGroupGetByName(ctx, req.FolderId, req.Name)
+	if err != nil {
+		s.markFailed(err)
+		return fmt.Errorf("unable to find instance group %s: %w", req.Name, err)
+	}
+
+	if ig == nil {
+		id, err := client.InstanceGroupCreate(ctx, req)
+		if err != nil {
+			s.markFailed(err)
+			return fmt.Errorf("failed to create instance group %s: %w", req.Name, err)
+		}
+
+		logger.Info("instance group creating", "instance-group-id", id)
+		s.scope.SetProviderID(id)
+		s.scope.SetInstanceGroupStatus(instancegroup.InstanceGroup_STARTING.String())
+		s.scope.SetReady(false)
+		conditions.MarkFalse(s.scope.YandexMachinePool,
+			infrav1.InstanceGroupReadyCondition,
+			infrav1.InstanceGroupUpdatingReason,
+			clusterv1.ConditionSeverityInfo, "instance group is creating")
+		return nil
+	}
+
+	s.scope.SetProviderID(ig.Id)
+	s.scope.SetInstanceGroupStatus(ig.Status.String())
+
+	upToDate := s.scope.IsInstanceGroupUpToDate(ig, req)
+	if !upToDate && ig.Status == instancegroup.InstanceGroup_ACTIVE {
+		logger.Info("updating instance group", "instance-group-id", ig.Id)
+		if err := client.InstanceGroupUpdate(ctx, &instancegroup.UpdateInstanceGroupRequest{
+			InstanceGroupId:  ig.Id,
+			UpdateMask:       &fieldmaskpb.FieldMask{Paths: updateMaskPaths},
+			Labels:           req.Labels,
+			InstanceTemplate: req.InstanceTemplate,
+			ScalePolicy:      req.ScalePolicy,
+			DeployPolicy:     req.DeployPolicy,
+			AllocationPolicy: req.AllocationPolicy,
+			ServiceAccountId: req.ServiceAccountId,
+		}); err != nil {
+			s.markFailed(err)
+			return fmt.Errorf("failed to update instance group %s: %w", ig.Id, err)
+		}
+	}
+
+	// The refreshed bootstrap data is applied to the new instances only,
+	// the running instances have already joined the cluster and should not be recreated.
+	bootstrapUpToDate := s.scope.IsInstanceGroupBootstrapDataUpToDate(ig, req)
+	if upToDate && !bootstrapUpToDate && ig.Status == instancegroup.InstanceGroup_ACTIVE {
+		logger.Info("updating instance group bootstrap data", "instance-group-id", ig.Id)
+		deployPolicy := proto.Clone(req.DeployPolicy).(*instancegroup.DeployPolicy)
+		deployPolicy.Strategy = instancegroup.DeployPolicy_OPPORTUNISTIC
+		if err := client.InstanceGroupUpdate(ctx, &instancegroup.UpdateInstanceGroupRequest{
+			InstanceGroupId:  ig.Id,
+			UpdateMask:       &fieldmaskpb.FieldMask{Paths: bootstrapUpdateMaskPaths},
+			Labels:           req.Labels,
+			InstanceTemplate: req.InstanceTemplate,
+			DeployPolicy:     deployPolicy,
+		}); err != nil {
+			s.markFailed(err)
+			return fmt.Errorf("failed to update instance group %s bootstrap data: %w", ig.Id, err)
+		}
+	}
+
+	instances, err := client.InstanceGroupListInstances(ctx, ig.Id)
+	if err != nil {
+		s.markFailed(err)
+		return fmt.Errorf("failed to list instances of the instance group %s: %w", ig.Id, err)
+	}
+
+	// The instances outdated by the bootstrap data update are not recreated, so they are counted as actual.
+	opportunistic := ig.GetDeployPolicy().GetStrategy() == instancegroup.DeployPolicy_OPPORTUNISTIC
+	var instanceIDs []string
+	var running, actual int64
+	for _, instance := range instances {
+		switch instance.Status {
+		case instancegroup.ManagedInstance_DELETING_INSTANCE, instancegroup.ManagedInstance_DELETED:
+			continue
+		case instancegroup.ManagedInstance_RUNNING_ACTUAL:
+			actual++
+			running++
+		case instancegroup.ManagedInstance_RUNNING_OUTDATED:
+			running++
+			if opportunistic {
+				actual++
+			}
+		}
+
+		if instance.InstanceId != "" {
+			instanceIDs = append(instanceIDs, instance.InstanceId)
+		}
+	}
+
+	replicas := s.scope.GetReplicas()
+	s.scope.SetProviderIDList(instanceIDs)
+	s.scope.SetReplicas(int32(running))
+	s.scope.SetReady(ig.Status == instancegroup.InstanceGroup_ACTIVE && running >= replicas)
+
+	if upToDate && bootstrapUpToDate && ig.Status == instancegroup.InstanceGroup_ACTIVE && actual == replicas {
+		conditions.MarkTrue(s.scope.YandexMachinePool, infrav1.InstanceGroupReadyCondition)
+		return nil
+	}
+
+	conditions.MarkFalse(s.scope.YandexMachinePool,
+		infrav1.InstanceGroupReadyCondition,
+		infrav1.InstanceGroupUpdatingReason,
+		clusterv1.ConditionSeverityInfo,
+		"instance group is %s, %d of %d instances are up to date", ig.Status.String(), actual, replicas)
+	return nil
+}
+
+// Delete deletes the instance group.
+// Returns true if the instance group does not exist anymore.
+func (s *Service) Delete(ctx context.Context) (bool, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("deleting instance group")
+
+	client := s.scope.GetClient()
+
+	ig, err := client.InstanceGroupGetByName(ctx, s.scope.ClusterGetter.GetFolderID(), s.scope.GetInstanceGroupName())
+	if err != nil {
+		return instanceGroupNotDeleted, fmt.Errorf("unable to find instance group %s for delete: %w",
+			s.scope.GetInstanceGroupName(), err)
+	}
+
+	if ig == nil {
+		logger.Info("instance group deleted")
+		return instanceGroupDeleted, nil
+	}
+
+	s.scope.SetInstanceGroupStatus(ig.Status.String())
+	s.scope.SetReady(false)
+	if ig.Status == instancegroup.InstanceGroup_DELETING {
+		return instanceGroupNotDeleted, nil
+	}
+
+	return instanceGroupNotDeleted, client.InstanceGroupDelete(ctx, ig.Id)
+}
+
+// markFailed marks the YandexMachinePool instance group as failed.
+func (s *Service) markFailed(err error) {
+	conditions.MarkFalse(s.scope.YandexMachinePool,
+		infrav1.InstanceGroupReadyCondition,
+		infrav1.InstanceGroupFailedReason,
+		clusterv1.ConditionSeverityError,
+		"%s", err.Error())
+}
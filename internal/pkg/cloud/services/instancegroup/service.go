@@ -0,0 +1,20 @@
+package instancegroup
+
+import (
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+)
+
+// Service implements instance groups reconciler.
+type Service struct {
+	scope *scope.MachinePoolScope
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns a new instance group service.
+func New(scp *scope.MachinePoolScope) *Service {
+	return &Service{
+		scope: scp,
+	}
+}
@@ -34,7 +34,12 @@ func (s *Service) reconcileALBService(ctx context.Context) error {
 		return err
 	}
 
-	return s.reconcileALB(ctx, backendGroupID)
+	securityGroupIDs, err := s.reconcileSecurityGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.reconcileALB(ctx, backendGroupID, securityGroupIDs)
 }
 
 // deleteALB deletes the YandexCloud application load balancers
@@ -58,6 +63,11 @@ func (s *Service) deleteALBService(ctx context.Context) (bool, error) {
 		return resourceNotDeleted, err
 	}
 
+	deleted, err = s.deleteSecurityGroup(ctx)
+	if err != nil || !deleted {
+		return resourceNotDeleted, err
+	}
+
 	return resourceDeleted, nil
 }
 
@@ -206,7 +216,7 @@ func (s *Service) reconcileALBBackendGroup(ctx context.Context, targetGroupID st
 }
 
 // reconcileApplicationLoadBalancer reconciles an ALB for kubernetes control plane.
-func (s *Service) reconcileALB(ctx context.Context, backendGroupID string) error {
+func (s *Service) reconcileALB(ctx context.Context, backendGroupID string, securityGroupIDs []string) error {
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("reconciling application load balancer")
 
@@ -217,6 +227,7 @@ func (s *Service) reconcileALB(ctx context.Context, backendGroupID string) error
 		WithFolder(s.scope.GetFolderID()).
 		WithBackendGroupID(backendGroupID).
 		WithNetworkID(s.scope.GetNetworkID()).
+		WithSecurityGroupIDs(securityGroupIDs).
 		WithLabels(s.scope.GetLabels())
 
 	lb, err := client.ALBGetByName(ctx, s.scope.GetFolderID(), builder.GetName())
@@ -225,8 +236,7 @@ func (s *Service) reconcileALB(ctx context.Context, backendGroupID string) error
 	}
 	switch {
 	case lb != nil && lb.Status == alb.LoadBalancer_ACTIVE && s.scope.GetLBSpec().Listener.Address != "":
-		// TODO: add support for external balancers
-		lbAddress, lbPort := s.getInternalAddress(lb), s.getInternalPort(lb)
+		lbAddress, lbPort := s.getListenerAddress(lb), s.getListenerPort(lb)
 		// TODO: reconile LB listener address/port in https://github.com/yandex-cloud/cluster-api-provider-yandex/issues/17
 		if lbAddress != "" && s.scope.GetLBSpec().Listener.Address != lbAddress {
 			return fmt.Errorf(
@@ -267,11 +277,20 @@ func (s *Service) reconcileALB(ctx context.Context, backendGroupID string) error
 	return nil
 }
 
-func (s *Service) getInternalAddress(lb *alb.LoadBalancer) string {
-	return lb.Listeners[0].GetEndpoints()[0].Addresses[0].GetInternalIpv4Address().Address
+// getListenerAddress returns the listener address of the application load balancer.
+// The external address is returned for the public listener, the internal one otherwise.
+func (s *Service) getListenerAddress(lb *alb.LoadBalancer) string {
+	address := lb.Listeners[0].GetEndpoints()[0].Addresses[0]
+	if external := address.GetExternalIpv4Address(); external != nil {
+		return external.GetAddress()
+	}
+	if external := address.GetExternalIpv6Address(); external != nil {
+		return external.GetAddress()
+	}
+	return address.GetInternalIpv4Address().GetAddress()
 }
 
-func (s *Service) getInternalPort(lb *alb.LoadBalancer) int32 {
+func (s *Service) getListenerPort(lb *alb.LoadBalancer) int32 {
 	return int32(lb.Listeners[0].GetEndpoints()[0].Ports[0])
 }
 
@@ -286,10 +305,9 @@ func (s *Service) describeALB(ctx context.Context) (infrav1.LoadBalancerStatus,
 		return infrav1.LoadBalancerStatus{}, err
 	}
 
-	// TODO: external address support.
 	status := infrav1.LoadBalancerStatus{
-		ListenerAddress: s.getInternalAddress(lb),
-		ListenerPort:    s.getInternalPort(lb),
+		ListenerAddress: s.getListenerAddress(lb),
+		ListenerPort:    s.getListenerPort(lb),
 	}
 	return status, nil
 }
@@ -42,6 +42,7 @@ type ALBBuilder struct {
 	clusterName      string
 	name             string
 	backendGroupID   string
+	securityGroupIDs []string
 	additionalLabels infrav1.Labels
 }
 
@@ -270,6 +271,12 @@ func (a *ALBBuilder) WithNetworkID(id string) *ALBBuilder {
 	return a
 }
 
+// WithSecurityGroupIDs sets the SecurityGroup IDs attached to the ALB.
+func (a *ALBBuilder) WithSecurityGroupIDs(ids []string) *ALBBuilder {
+	a.securityGroupIDs = ids
+	return a
+}
+
 // WithLabels sets an additional set of tags on ALB.
 func (a *ALBBuilder) WithLabels(labels infrav1.Labels) *ALBBuilder {
 	a.additionalLabels = labels
@@ -285,18 +292,16 @@ func (a *ALBBuilder) Build() (*alb.CreateLoadBalancerRequest, error) {
 		Description: describePrefix + a.clusterName + " loadbalancer",
 	}
 
-	// Single zone allocation only in alpha version.
-	request.SetAllocationPolicy(&alb.AllocationPolicy{
-		Locations: []*alb.Location{{
-			ZoneId:   a.lbs.Listener.Subnet.ZoneID,
-			SubnetId: a.lbs.Listener.Subnet.ID,
-		}},
-	})
+	request.SetAllocationPolicy(a.createAllocationPolicy())
 
 	if a.additionalLabels != nil {
 		request.SetLabels(a.additionalLabels)
 	}
 
+	if len(a.securityGroupIDs) > 0 {
+		request.SetSecurityGroupIds(a.securityGroupIDs)
+	}
+
 	request.SetListenerSpecs(a.createListenerSpec(
 		a.lbs.Listener.Address,
 		a.lbs.Listener.Port,
@@ -312,15 +317,50 @@ func (a *ALBBuilder) GetName() string {
 	return a.name
 }
 
+// createAllocationPolicy prepares and returns the ALB allocation policy.
+// The load balancer is allocated in the listener subnet zone, if locations are not provided.
+func (a *ALBBuilder) createAllocationPolicy() *alb.AllocationPolicy {
+	subnets := a.lbs.Locations
+	if len(subnets) == 0 {
+		subnets = []infrav1.SubnetSpec{a.lbs.Listener.Subnet}
+	}
+
+	locations := make([]*alb.Location, 0, len(subnets))
+	for _, subnet := range subnets {
+		locations = append(locations, &alb.Location{
+			ZoneId:   subnet.ZoneID,
+			SubnetId: subnet.ID,
+		})
+	}
+
+	return &alb.AllocationPolicy{Locations: locations}
+}
+
 // createListenerSpec prepares and returns the ALB Listener specification.
 func (a *ALBBuilder) createListenerSpec(address string, port int32, subnetID, backendID string) []*alb.ListenerSpec {
-	intAddressSpec := &alb.InternalIpv4AddressSpec{}
-	if address != "" {
-		intAddressSpec.SetAddress(address)
-	}
-	intAddressSpec.SetSubnetId(subnetID)
 	addressSpec := &alb.AddressSpec{}
-	addressSpec.SetInternalIpv4AddressSpec(intAddressSpec)
+	if a.lbs.Listener.Internal {
+		intAddressSpec := &alb.InternalIpv4AddressSpec{}
+		if address != "" {
+			intAddressSpec.SetAddress(address)
+		}
+		intAddressSpec.SetSubnetId(subnetID)
+		addressSpec.SetInternalIpv4AddressSpec(intAddressSpec)
+	} else if a.lbs.Listener.IPVersion == infrav1.IPVersionIPv6 {
+		// Public IPv6 address will be allocated automatically if the static address is not provided.
+		extAddressSpec := &alb.ExternalIpv6AddressSpec{}
+		if address != "" {
+			extAddressSpec.SetAddress(address)
+		}
+		addressSpec.SetExternalIpv6AddressSpec(extAddressSpec)
+	} else {
+		// Public IPv4 address will be allocated automatically if the static address is not provided.
+		extAddressSpec := &alb.ExternalIpv4AddressSpec{}
+		if address != "" {
+			extAddressSpec.SetAddress(address)
+		}
+		addressSpec.SetExternalIpv4AddressSpec(extAddressSpec)
+	}
 
 	endpointSpec := &alb.EndpointSpec{}
 	endpointSpec.SetPorts([]int64{int64(port)})
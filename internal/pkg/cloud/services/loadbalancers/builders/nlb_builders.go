@@ -0,0 +1,242 @@
+package builders
+
+import (
+	"time"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	nlb "github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// defaultRegionID is the YandexCloud region for network load balancer resources.
+	defaultRegionID = "ru-central1"
+)
+
+// NLBTargetGroupBuilder defines a builder for a network load balancer target group request.
+type NLBTargetGroupBuilder struct {
+	lbs              infrav1.LoadBalancerSpec
+	folderID         string
+	clusterName      string
+	name             string
+	subnetID         string
+	targetGroupID    string
+	ipAddress        string
+	additionalLabels infrav1.Labels
+}
+
+// NLBBuilder defines a builder for a network load balancer request.
+type NLBBuilder struct {
+	lbs              infrav1.LoadBalancerSpec
+	folderID         string
+	clusterName      string
+	name             string
+	targetGroupID    string
+	additionalLabels infrav1.Labels
+}
+
+// NewNLBTargetGroupBuilder returns the new NLBTargetGroupBuilder.
+func NewNLBTargetGroupBuilder(lbs infrav1.LoadBalancerSpec) *NLBTargetGroupBuilder {
+	return &NLBTargetGroupBuilder{lbs: lbs}
+}
+
+// WithCluster sets the CAPI cluster name.
+func (n *NLBTargetGroupBuilder) WithCluster(clusterName string) *NLBTargetGroupBuilder {
+	n.clusterName = clusterName
+	return n
+}
+
+// WithFolder sets the YandexCloud FolderID.
+func (n *NLBTargetGroupBuilder) WithFolder(folderID string) *NLBTargetGroupBuilder {
+	n.folderID = folderID
+	return n
+}
+
+// WithLBName sets the TargetGroup name.
+func (n *NLBTargetGroupBuilder) WithLBName(name string) *NLBTargetGroupBuilder {
+	n.name = name
+	return n
+}
+
+// WithLabels sets an additional set of tags on TargetGroup.
+func (n *NLBTargetGroupBuilder) WithLabels(labels infrav1.Labels) *NLBTargetGroupBuilder {
+	n.additionalLabels = labels
+	return n
+}
+
+// WithSubnetID sets the YandexCloud SubnetID.
+func (n *NLBTargetGroupBuilder) WithSubnetID(id string) *NLBTargetGroupBuilder {
+	n.subnetID = id
+	return n
+}
+
+// WithTargetGroupID sets the YandexCloud TargetGroupID.
+func (n *NLBTargetGroupBuilder) WithTargetGroupID(id string) *NLBTargetGroupBuilder {
+	n.targetGroupID = id
+	return n
+}
+
+// WithIP sets the target IP address.
+func (n *NLBTargetGroupBuilder) WithIP(ipAddress string) *NLBTargetGroupBuilder {
+	n.ipAddress = ipAddress
+	return n
+}
+
+// Build prepares and returns the NLB target group creation request.
+func (n *NLBTargetGroupBuilder) Build() (*nlb.CreateTargetGroupRequest, error) {
+	request := &nlb.CreateTargetGroupRequest{
+		FolderId:    n.folderID,
+		Name:        n.name,
+		RegionId:    defaultRegionID,
+		Description: describePrefix + n.clusterName + " target",
+	}
+
+	if n.additionalLabels != nil {
+		request.SetLabels(n.additionalLabels)
+	}
+
+	return request, nil
+}
+
+// BuildAddTargetRequest returns the NLB AddTargetsRequest.
+// address: IPv4 address.
+func (n *NLBTargetGroupBuilder) BuildAddTargetRequest(address string) *nlb.AddTargetsRequest {
+	return &nlb.AddTargetsRequest{
+		TargetGroupId: n.targetGroupID,
+		Targets: []*nlb.Target{{
+			SubnetId: n.subnetID,
+			Address:  address,
+		}},
+	}
+}
+
+// BuildRemoveTargetRequest returns the NLB RemoveTargetsRequest.
+// address: IPv4 address.
+func (n *NLBTargetGroupBuilder) BuildRemoveTargetRequest(address string) *nlb.RemoveTargetsRequest {
+	return &nlb.RemoveTargetsRequest{
+		TargetGroupId: n.targetGroupID,
+		Targets: []*nlb.Target{{
+			SubnetId: n.subnetID,
+			Address:  address,
+		}},
+	}
+}
+
+// GetName gets the NLB target group name from NLBTargetGroupBuilder.
+func (n *NLBTargetGroupBuilder) GetName() string {
+	return n.name
+}
+
+// NewNLBBuilder returns the new NLBBuilder.
+func NewNLBBuilder(lbs infrav1.LoadBalancerSpec) *NLBBuilder {
+	return &NLBBuilder{lbs: lbs}
+}
+
+// WithCluster sets the CAPI cluster name.
+func (n *NLBBuilder) WithCluster(clusterName string) *NLBBuilder {
+	n.clusterName = clusterName
+	return n
+}
+
+// WithFolder sets YandexCloud FolderID.
+func (n *NLBBuilder) WithFolder(folderID string) *NLBBuilder {
+	n.folderID = folderID
+	return n
+}
+
+// WithName sets the NLB name.
+func (n *NLBBuilder) WithName(name string) *NLBBuilder {
+	n.name = name
+	return n
+}
+
+// WithTargetGroupID sets the TargetGroup ID attached to the NLB.
+func (n *NLBBuilder) WithTargetGroupID(id string) *NLBBuilder {
+	n.targetGroupID = id
+	return n
+}
+
+// WithLabels sets an additional set of tags on NLB.
+func (n *NLBBuilder) WithLabels(labels infrav1.Labels) *NLBBuilder {
+	n.additionalLabels = labels
+	return n
+}
+
+// Build prepares and returns the NLB creation request.
+func (n *NLBBuilder) Build() (*nlb.CreateNetworkLoadBalancerRequest, error) {
+	request := &nlb.CreateNetworkLoadBalancerRequest{
+		FolderId:    n.folderID,
+		Name:        n.name,
+		RegionId:    defaultRegionID,
+		Description: describePrefix + n.clusterName + " loadbalancer",
+	}
+
+	if n.lbs.Listener.Internal {
+		request.SetType(nlb.NetworkLoadBalancer_INTERNAL)
+	} else {
+		request.SetType(nlb.NetworkLoadBalancer_EXTERNAL)
+	}
+
+	if n.additionalLabels != nil {
+		request.SetLabels(n.additionalLabels)
+	}
+
+	request.SetListenerSpecs(n.createListenerSpec())
+	request.SetAttachedTargetGroups([]*nlb.AttachedTargetGroup{{
+		TargetGroupId: n.targetGroupID,
+		HealthChecks:  n.createHealthChecks(),
+	}})
+
+	return request, nil
+}
+
+// GetName gets the NLB name from NLBBuilder.
+func (n *NLBBuilder) GetName() string {
+	return n.name
+}
+
+// createListenerSpec prepares and returns the NLB Listener specification.
+func (n *NLBBuilder) createListenerSpec() []*nlb.ListenerSpec {
+	listenerSpec := &nlb.ListenerSpec{}
+	listenerSpec.SetName(n.name)
+	listenerSpec.SetPort(int64(n.lbs.Listener.Port))
+	listenerSpec.SetTargetPort(int64(n.lbs.BackendPort))
+	listenerSpec.SetProtocol(nlb.Listener_TCP)
+
+	ipVersion := nlb.IpVersion_IPV4
+	if n.lbs.Listener.IPVersion == infrav1.IPVersionIPv6 {
+		ipVersion = nlb.IpVersion_IPV6
+	}
+
+	if n.lbs.Listener.Internal {
+		listenerSpec.SetInternalAddressSpec(&nlb.InternalAddressSpec{
+			Address:   n.lbs.Listener.Address,
+			SubnetId:  n.lbs.Listener.Subnet.ID,
+			IpVersion: ipVersion,
+		})
+	} else {
+		listenerSpec.SetExternalAddressSpec(&nlb.ExternalAddressSpec{
+			Address:   n.lbs.Listener.Address,
+			IpVersion: ipVersion,
+		})
+	}
+
+	return []*nlb.ListenerSpec{listenerSpec}
+}
+
+// createHealthChecks creates list of TCP healtchecks for NLB.
+func (n *NLBBuilder) createHealthChecks() []*nlb.HealthCheck {
+	var healthChecks []*nlb.HealthCheck
+	timeout := time.Second * time.Duration(n.lbs.Healthcheck.HealthcheckTimeoutSec)
+	interval := time.Second * time.Duration(n.lbs.Healthcheck.HealthcheckIntervalSec)
+
+	healthCheck := &nlb.HealthCheck{}
+	healthCheck.SetName(n.name)
+	healthCheck.SetTimeout(durationpb.New(timeout))
+	healthCheck.SetInterval(durationpb.New(interval))
+	healthCheck.SetUnhealthyThreshold(int64(n.lbs.Healthcheck.HealthcheckThreshold))
+	healthCheck.SetHealthyThreshold(int64(n.lbs.Healthcheck.HealthcheckThreshold))
+	healthCheck.SetTcpOptions(&nlb.HealthCheck_TcpOptions{Port: int64(n.lbs.BackendPort)})
+
+	return append(healthChecks, healthCheck)
+}
@@ -0,0 +1,115 @@
+package builders
+
+import (
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+const (
+	// albHealthcheckPort is the port used by YandexCloud to check the health of the ALB nodes.
+	// More information https://yandex.cloud/ru/docs/application-load-balancer/concepts/application-load-balancer#security-groups.
+	albHealthcheckPort = 30080
+	// healthcheckPredefinedTarget is the YandexCloud predefined target for the load balancer health checks.
+	healthcheckPredefinedTarget = "loadbalancer_healthchecks"
+	anyIPv4CIDR                 = "0.0.0.0/0"
+	anyIPv6CIDR                 = "::/0"
+	protocolTCP                 = "TCP"
+)
+
+// SecurityGroupBuilder defines a builder for an application load balancer security group request.
+type SecurityGroupBuilder struct {
+	lbs              infrav1.LoadBalancerSpec
+	folderID         string
+	networkID        string
+	clusterName      string
+	name             string
+	additionalLabels infrav1.Labels
+}
+
+// NewSecurityGroupBuilder returns the new SecurityGroupBuilder.
+func NewSecurityGroupBuilder(lbs infrav1.LoadBalancerSpec) *SecurityGroupBuilder {
+	return &SecurityGroupBuilder{lbs: lbs}
+}
+
+// WithCluster sets the CAPI cluster name.
+func (s *SecurityGroupBuilder) WithCluster(clusterName string) *SecurityGroupBuilder {
+	s.clusterName = clusterName
+	return s
+}
+
+// WithFolder sets the YandexCloud FolderID.
+func (s *SecurityGroupBuilder) WithFolder(folderID string) *SecurityGroupBuilder {
+	s.folderID = folderID
+	return s
+}
+
+// WithNetworkID sets the Network ID.
+func (s *SecurityGroupBuilder) WithNetworkID(id string) *SecurityGroupBuilder {
+	s.networkID = id
+	return s
+}
+
+// WithLBName sets the SecurityGroup name.
+func (s *SecurityGroupBuilder) WithLBName(name string) *SecurityGroupBuilder {
+	s.name = name
+	return s
+}
+
+// WithLabels sets an additional set of tags on SecurityGroup.
+func (s *SecurityGroupBuilder) WithLabels(labels infrav1.Labels) *SecurityGroupBuilder {
+	s.additionalLabels = labels
+	return s
+}
+
+// Build prepares and returns the SecurityGroup creation request.
+// The security group allows incoming traffic to the listener port and from the load balancer health checks,
+// and outgoing traffic to the backend port of the targets.
+func (s *SecurityGroupBuilder) Build() (*vpc.CreateSecurityGroupRequest, error) {
+	request := &vpc.CreateSecurityGroupRequest{
+		FolderId:    s.folderID,
+		NetworkId:   s.networkID,
+		Name:        s.name,
+		Description: describePrefix + s.clusterName + " loadbalancer security group",
+	}
+
+	if s.additionalLabels != nil {
+		request.SetLabels(s.additionalLabels)
+	}
+
+	listenerRule := s.createRuleSpec(vpc.SecurityGroupRule_INGRESS, int64(s.lbs.Listener.Port), "kubernetes api listener")
+	listenerRule.SetCidrBlocks(createCidrBlocks(s.lbs.Listener.IPVersion == infrav1.IPVersionIPv6))
+
+	healthcheckRule := s.createRuleSpec(vpc.SecurityGroupRule_INGRESS, albHealthcheckPort, "load balancer health checks")
+	healthcheckRule.SetPredefinedTarget(healthcheckPredefinedTarget)
+
+	backendRule := s.createRuleSpec(vpc.SecurityGroupRule_EGRESS, int64(s.lbs.BackendPort), "kubernetes api backends")
+	// The application load balancer reaches the targets by IPv4 addresses only.
+	backendRule.SetCidrBlocks(createCidrBlocks(false))
+
+	request.SetRuleSpecs([]*vpc.SecurityGroupRuleSpec{listenerRule, healthcheckRule, backendRule})
+
+	return request, nil
+}
+
+// createCidrBlocks returns the CIDR blocks matching any IPv4 or any IPv6 address.
+func createCidrBlocks(ipv6 bool) *vpc.CidrBlocks {
+	if ipv6 {
+		return &vpc.CidrBlocks{V6CidrBlocks: []string{anyIPv6CIDR}}
+	}
+	return &vpc.CidrBlocks{V4CidrBlocks: []string{anyIPv4CIDR}}
+}
+
+// GetName gets the SecurityGroup name from SecurityGroupBuilder.
+func (s *SecurityGroupBuilder) GetName() string {
+	return s.name
+}
+
+// createRuleSpec prepares and returns the single TCP port rule specification.
+func (s *SecurityGroupBuilder) createRuleSpec(direction vpc.SecurityGroupRule_Direction, port int64, description string) *vpc.SecurityGroupRuleSpec {
+	rule := &vpc.SecurityGroupRuleSpec{}
+	rule.SetDescription(description)
+	rule.SetDirection(direction)
+	rule.SetProtocolName(protocolTCP)
+	rule.SetPorts(&vpc.PortRange{FromPort: port, ToPort: port})
+	return rule
+}
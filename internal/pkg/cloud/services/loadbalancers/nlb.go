@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	nlb "github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers/builders"
 )
 
 // reconcileNLBService reconciles the YandexCloud network load balancer
@@ -15,7 +18,12 @@ func (s *Service) reconcileNLBService(ctx context.Context) error {
 	logger := log.FromContext(ctx)
 	logger.Info("reconciling network loadbalancer instance")
 
-	return fmt.Errorf("NLB support will be added in future releases, use ALB instead")
+	targetGroupID, err := s.reconcileNLBTargetGroup(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.reconcileNLB(ctx, targetGroupID)
 }
 
 // deleteNLBService deletes the YandexCloud network load balancer
@@ -24,32 +32,285 @@ func (s *Service) deleteNLBService(ctx context.Context) (bool, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("deleting network loadbalancer instance")
 
-	return false, fmt.Errorf("NLB support will be added in future releases, use ALB instead")
+	deleted, err := s.deleteNetworkLoadBalancer(ctx)
+	if err != nil || !deleted {
+		return resourceNotDeleted, err
+	}
+
+	deleted, err = s.deleteNLBTargetGroup(ctx)
+	if err != nil || !deleted {
+		return resourceNotDeleted, err
+	}
+
+	return resourceDeleted, nil
+}
+
+// deleteNetworkLoadBalancer deletes the YandexCloud network load balancer.
+func (s *Service) deleteNetworkLoadBalancer(ctx context.Context) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	client := s.scope.GetClient()
+	lb, err := client.NLBGetByName(ctx, s.scope.GetFolderID(), s.scope.GetLBName())
+	if err != nil {
+		return resourceNotDeleted, err
+	}
+
+	// The load balancer has already been deleted.
+	if lb == nil {
+		return resourceDeleted, nil
+	}
+
+	// Check the load balancer status. If the load balancer is already being deleted, we do nothing.
+	// Create load balancer deletion request otherwise.
+	status := lb.GetStatus()
+	logger.V(1).Info("network load balancer status", "status", status.String())
+	if status == nlb.NetworkLoadBalancer_DELETING {
+		return resourceNotDeleted, nil
+	}
+
+	return resourceNotDeleted, client.NLBDelete(ctx, lb.GetId())
+}
+
+// deleteNLBTargetGroup deletes an NLB target group.
+func (s *Service) deleteNLBTargetGroup(ctx context.Context) (bool, error) {
+	client := s.scope.GetClient()
+	name := builders.NewNLBTargetGroupBuilder(s.scope.GetLBSpec()).
+		WithLBName(s.scope.GetLBName()).
+		GetName()
+
+	tg, err := client.NLBTargetGroupGetByName(ctx, s.scope.GetFolderID(), name)
+	if err != nil {
+		return resourceNotDeleted, err
+	}
+
+	if tg == nil {
+		return resourceDeleted, nil
+	}
+
+	return resourceNotDeleted, client.NLBTargetGroupDelete(ctx, tg.GetId())
+}
+
+// reconcileNLBTargetGroup reconciles an NLB target group for kubernetes control plane.
+// Returns ID of NLB target group.
+func (s *Service) reconcileNLBTargetGroup(ctx context.Context) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("reconciling network load balancer target group")
+
+	client := s.scope.GetClient()
+
+	builder := builders.NewNLBTargetGroupBuilder(s.scope.GetLBSpec()).
+		WithCluster(s.scope.Name()).
+		WithLBName(s.scope.GetLBName()).
+		WithFolder(s.scope.GetFolderID()).
+		WithLabels(s.scope.GetLabels())
+
+	tg, err := client.NLBTargetGroupGetByName(ctx, s.scope.GetFolderID(), builder.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	if tg == nil {
+		logger.V(1).Info("creating network load balancer target group")
+		req, err := builder.Build()
+		if err != nil {
+			return "", err
+		}
+
+		id, err := client.NLBTargetGroupCreate(ctx, req)
+		if err != nil {
+			return "", err
+		}
+
+		logger.Info("network load balancer target group created", "instance id", id)
+		return id, nil
+	}
+
+	return tg.Id, nil
+}
+
+// reconcileNLB reconciles an NLB for kubernetes control plane.
+func (s *Service) reconcileNLB(ctx context.Context, targetGroupID string) error {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("reconciling network load balancer")
+
+	client := s.scope.GetClient()
+	builder := builders.NewNLBBuilder(s.scope.GetLBSpec()).
+		WithCluster(s.scope.Name()).
+		WithName(s.scope.GetLBName()).
+		WithFolder(s.scope.GetFolderID()).
+		WithTargetGroupID(targetGroupID).
+		WithLabels(s.scope.GetLabels())
+
+	lb, err := client.NLBGetByName(ctx, s.scope.GetFolderID(), builder.GetName())
+	if err != nil {
+		return err
+	}
+	switch {
+	case lb != nil && s.isNLBOperational(lb) && s.scope.GetLBSpec().Listener.Address != "":
+		lbAddress, lbPort := s.getNLBAddress(lb), s.getNLBPort(lb)
+		if lbAddress != "" && s.scope.GetLBSpec().Listener.Address != lbAddress {
+			return fmt.Errorf(
+				"load balancer for the YandexCluster %s has an incorrect address %s, expected %s. "+
+					"The cluster has become unrecoverable and should be manually deleted",
+				s.scope.Name(), lbAddress,
+				s.scope.GetLBSpec().Listener.Address)
+		}
+		if lbPort != 0 && s.scope.GetLBSpec().Listener.Port != lbPort {
+			return fmt.Errorf(
+				"load balancer for the YandexCluster %s has an incorrect port %d, expected %d. "+
+					"The cluster has become unrecoverable and should be manually deleted",
+				s.scope.Name(), lbPort,
+				s.scope.GetLBSpec().Listener.Port)
+		}
+	case lb == nil && s.scope.ControlPlaneEndpoint().IsValid() && s.scope.GetLBSpec().Listener.Address == "":
+		// if load balancer is not found and cluster ControlPlaneEndpoint is already populated, then we have to recreate cluster.
+		return fmt.Errorf("load balancer for the YandexCluster %s not found, the cluster has become unrecoverable and should be manually deleted",
+			s.scope.YandexCluster.Name)
+
+	case lb == nil:
+		// if load balancer is not found, create it.
+		logger.Info("creating network load balancer. It may take a while, please be patient.")
+		req, err := builder.Build()
+		if err != nil {
+			return err
+		}
+
+		id, err := client.NLBCreate(ctx, req)
+		if err != nil {
+			return err
+		}
+		logger.Info("network loadbalancer created", "instance id", id)
+		conditions.MarkTrue(s.scope.YandexCluster, infrav1.ConditionStatusReady)
+		return nil
+	}
+
+	return nil
+}
+
+// isNLBOperational returns true when the network load balancer is able to accept targets.
+// A freshly created NLB reports INACTIVE until at least one healthy target is registered,
+// so both ACTIVE and INACTIVE statuses are considered operational.
+func (s *Service) isNLBOperational(lb *nlb.NetworkLoadBalancer) bool {
+	status := lb.GetStatus()
+	return status == nlb.NetworkLoadBalancer_ACTIVE || status == nlb.NetworkLoadBalancer_INACTIVE
+}
+
+// getNLBAddress returns the address of the network load balancer listener,
+// or an empty string if the load balancer has no listeners yet.
+func (s *Service) getNLBAddress(lb *nlb.NetworkLoadBalancer) string {
+	if len(lb.GetListeners()) == 0 {
+		return ""
+	}
+	return lb.GetListeners()[0].GetAddress()
+}
+
+// getNLBPort returns the port of the network load balancer listener,
+// or zero if the load balancer has no listeners yet.
+func (s *Service) getNLBPort(lb *nlb.NetworkLoadBalancer) int32 {
+	if len(lb.GetListeners()) == 0 {
+		return 0
+	}
+	return int32(lb.GetListeners()[0].GetPort())
 }
 
 // describeNLB returns the IP address and port of the network load balancer listener.
 func (s *Service) describeNLB(ctx context.Context) (infrav1.LoadBalancerStatus, error) {
-	_ = ctx
-	return infrav1.LoadBalancerStatus{}, fmt.Errorf("NLB support will be added in future releases, use ALB instead")
+	lb, err := s.scope.GetClient().NLBGetByName(
+		ctx,
+		s.scope.GetFolderID(),
+		s.scope.GetLBName(),
+	)
+	if err != nil {
+		return infrav1.LoadBalancerStatus{}, err
+	}
+
+	if lb == nil || len(lb.GetListeners()) == 0 {
+		return infrav1.LoadBalancerStatus{}, fmt.Errorf("network load balancer %s has no listeners", s.scope.GetLBName())
+	}
+
+	status := infrav1.LoadBalancerStatus{
+		ListenerAddress: s.getNLBAddress(lb),
+		ListenerPort:    s.getNLBPort(lb),
+	}
+	return status, nil
 }
 
 // addTargetNLB adds the IP address to the network load balancer's target group.
-func (s *Service) addTargetNLB(ctx context.Context, addr, subnetID string) error {
-	//nolint:dogsled // placeholder
-	_, _, _ = ctx, addr, subnetID
-	return fmt.Errorf("NLB support will be added in future releases, use ALB instead")
+func (s *Service) addTargetNLB(ctx context.Context, ipAddress, subnetID string) error {
+	builder := builders.NewNLBTargetGroupBuilder(s.scope.GetLBSpec()).
+		WithCluster(s.scope.Name()).
+		WithLBName(s.scope.GetLBName()).
+		WithIP(ipAddress).
+		WithSubnetID(subnetID).
+		WithFolder(s.scope.GetFolderID())
+
+	tg, err := s.scope.GetClient().NLBTargetGroupGetByName(ctx, s.scope.GetFolderID(), builder.GetName())
+	if err != nil {
+		return err
+	}
+
+	if tg == nil {
+		return fmt.Errorf("target group with name %s not found", builder.GetName())
+	}
+
+	if s.isAddressRegisteredNLB(ipAddress, subnetID, tg) {
+		return nil
+	}
+
+	req := builder.WithTargetGroupID(tg.Id).BuildAddTargetRequest(ipAddress)
+	_, err = s.scope.GetClient().NLBAddTarget(ctx, req)
+	return err
 }
 
 // removeTargetNLB removes the IP address from the network load balancer's target group.
-func (s *Service) removeTargetNLB(ctx context.Context, addr, subnetID string) error {
-	//nolint:dogsled // placeholder
-	_, _, _ = ctx, addr, subnetID
-	return fmt.Errorf("NLB support will be added in future releases, use ALB instead")
+func (s *Service) removeTargetNLB(ctx context.Context, ipAddress, subnetID string) error {
+	builder := builders.NewNLBTargetGroupBuilder(s.scope.GetLBSpec()).
+		WithCluster(s.scope.Name()).
+		WithLBName(s.scope.GetLBName()).
+		WithIP(ipAddress).
+		WithSubnetID(subnetID).
+		WithFolder(s.scope.GetFolderID())
+
+	tg, err := s.scope.GetClient().NLBTargetGroupGetByName(ctx, s.scope.GetFolderID(), builder.GetName())
+	if err != nil {
+		return err
+	}
+
+	// If TargetGroup is nil, it means the user deleted it manually.
+	// In this case, we take no action and consider it a normal scenario.
+	if tg == nil {
+		return nil
+	}
+
+	if !s.isAddressRegisteredNLB(ipAddress, subnetID, tg) {
+		return nil
+	}
+
+	req := builder.WithTargetGroupID(tg.Id).BuildRemoveTargetRequest(ipAddress)
+	_, err = s.scope.GetClient().NLBRemoveTarget(ctx, req)
+	return err
+}
+
+// isAddressRegisteredNLB checks that the instance address is already registered to the NLB target group.
+func (s *Service) isAddressRegisteredNLB(addr, subnetID string, tg *nlb.TargetGroup) bool {
+	for _, target := range tg.Targets {
+		if target.Address == addr && target.SubnetId == subnetID {
+			return true
+		}
+	}
+	return false
 }
 
-// isActiveNLB returns true when the network load balancer instance have an ACTIVE status.
+// isActiveNLB returns true when the network load balancer instance is operational.
 func (s *Service) isActiveNLB(ctx context.Context) (bool, error) {
-	//nolint:dogsled // placeholder
-	_ = ctx
-	return false, fmt.Errorf("NLB support will be added in future releases, use ALB instead")
+	lb, err := s.scope.GetClient().NLBGetByName(ctx, s.scope.GetFolderID(), s.scope.GetLBName())
+	if err != nil {
+		return false, err
+	}
+
+	if lb == nil {
+		return false, nil
+	}
+
+	return s.isNLBOperational(lb), nil
 }
@@ -0,0 +1,88 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/services/loadbalancers/builders"
+)
+
+// reconcileSecurityGroups returns security group IDs for the load balancer.
+// If security groups are provided in the load balancer specification, they are used as is.
+// Otherwise the managed security group is reconciled.
+func (s *Service) reconcileSecurityGroups(ctx context.Context) ([]string, error) {
+	if ids := s.scope.GetLBSpec().SecurityGroups; len(ids) > 0 {
+		return ids, nil
+	}
+
+	id, err := s.reconcileSecurityGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{id}, nil
+}
+
+// reconcileSecurityGroup reconciles the managed security group for the load balancer.
+// Returns ID of the security group.
+func (s *Service) reconcileSecurityGroup(ctx context.Context) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("reconciling load balancer security group")
+
+	client := s.scope.GetClient()
+
+	builder := builders.NewSecurityGroupBuilder(s.scope.GetLBSpec()).
+		WithCluster(s.scope.Name()).
+		WithLBName(s.scope.GetLBName()).
+		WithFolder(s.scope.GetFolderID()).
+		WithNetworkID(s.scope.GetNetworkID()).
+		WithLabels(s.scope.GetLabels())
+
+	sg, err := client.VPCSecurityGroupGetByName(ctx, s.scope.GetFolderID(), builder.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	if sg == nil {
+		logger.V(1).Info("creating load balancer security group")
+		req, err := builder.Build()
+		if err != nil {
+			return "", err
+		}
+
+		id, err := client.VPCSecurityGroupCreate(ctx, req)
+		if err != nil {
+			return "", err
+		}
+
+		logger.Info("load balancer security group created", "instance id", id)
+		return id, nil
+	}
+
+	return sg.Id, nil
+}
+
+// deleteSecurityGroup deletes the managed security group of the load balancer.
+// Security groups provided in the load balancer specification are not managed by the provider and are left untouched.
+func (s *Service) deleteSecurityGroup(ctx context.Context) (bool, error) {
+	if len(s.scope.GetLBSpec().SecurityGroups) > 0 {
+		return resourceDeleted, nil
+	}
+
+	client := s.scope.GetClient()
+	name := builders.NewSecurityGroupBuilder(s.scope.GetLBSpec()).
+		WithLBName(s.scope.GetLBName()).
+		GetName()
+
+	sg, err := client.VPCSecurityGroupGetByName(ctx, s.scope.GetFolderID(), name)
+	if err != nil {
+		return resourceNotDeleted, err
+	}
+
+	if sg == nil {
+		return resourceDeleted, nil
+	}
+
+	return resourceNotDeleted, client.VPCSecurityGroupDelete(ctx, sg.GetId())
+}
@@ -0,0 +1,2 @@
+// Package network has all services and interface to work with the YandexCloud VPC network API.
+package network
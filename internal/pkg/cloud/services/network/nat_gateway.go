@@ -0,0 +1,120 @@
+package network
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// anyIPv4CIDR is the destination prefix of the default route through the NAT gateway.
+const anyIPv4CIDR = "0.0.0.0/0"
+
+// reconcileNATGateway reconciles the shared egress NAT gateway.
+// Returns ID of the gateway.
+func (s *Service) reconcileNATGateway(ctx context.Context) (string, error) {
+	logger := log.FromContext(ctx)
+	client := s.scope.GetClient()
+
+	gw, err := client.VPCGatewayGetByName(ctx, s.scope.GetFolderID(), s.scope.GetNATGatewayName())
+	if err != nil {
+		return "", err
+	}
+
+	if gw != nil {
+		return gw.GetId(), nil
+	}
+
+	logger.Info("creating NAT gateway", "name", s.scope.GetNATGatewayName())
+	req := &vpc.CreateGatewayRequest{
+		FolderId:    s.scope.GetFolderID(),
+		Name:        s.scope.GetNATGatewayName(),
+		Description: describePrefix + s.scope.Name() + " NAT gateway",
+	}
+	req.SetSharedEgressGatewaySpec(&vpc.SharedEgressGatewaySpec{})
+	if labels := s.scope.GetLabels(); labels != nil {
+		req.SetLabels(labels)
+	}
+
+	id, err := client.VPCGatewayCreate(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("NAT gateway created", "id", id)
+	return id, nil
+}
+
+// reconcileRouteTable reconciles the route table, which routes all outgoing traffic through the NAT gateway.
+// Returns ID of the route table.
+func (s *Service) reconcileRouteTable(ctx context.Context, networkID, gatewayID string) (string, error) {
+	logger := log.FromContext(ctx)
+	client := s.scope.GetClient()
+
+	rt, err := client.VPCRouteTableGetByName(ctx, s.scope.GetFolderID(), s.scope.GetRouteTableName())
+	if err != nil {
+		return "", err
+	}
+
+	if rt != nil {
+		return rt.GetId(), nil
+	}
+
+	logger.Info("creating route table", "name", s.scope.GetRouteTableName())
+	route := &vpc.StaticRoute{}
+	route.SetDestinationPrefix(anyIPv4CIDR)
+	route.SetGatewayId(gatewayID)
+
+	req := &vpc.CreateRouteTableRequest{
+		FolderId:     s.scope.GetFolderID(),
+		Name:         s.scope.GetRouteTableName(),
+		Description:  describePrefix + s.scope.Name() + " NAT route table",
+		NetworkId:    networkID,
+		StaticRoutes: []*vpc.StaticRoute{route},
+	}
+	if labels := s.scope.GetLabels(); labels != nil {
+		req.SetLabels(labels)
+	}
+
+	id, err := client.VPCRouteTableCreate(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("route table created", "id", id)
+	return id, nil
+}
+
+// deleteRouteTable deletes the route table.
+func (s *Service) deleteRouteTable(ctx context.Context) error {
+	client := s.scope.GetClient()
+
+	rt, err := client.VPCRouteTableGetByName(ctx, s.scope.GetFolderID(), s.scope.GetRouteTableName())
+	if err != nil || rt == nil {
+		return err
+	}
+
+	if err := client.VPCRouteTableDelete(ctx, rt.GetId()); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("route table deleted", "id", rt.GetId())
+	return nil
+}
+
+// deleteNATGateway deletes the NAT gateway.
+func (s *Service) deleteNATGateway(ctx context.Context) error {
+	client := s.scope.GetClient()
+
+	gw, err := client.VPCGatewayGetByName(ctx, s.scope.GetFolderID(), s.scope.GetNATGatewayName())
+	if err != nil || gw == nil {
+		return err
+	}
+
+	if err := client.VPCGatewayDelete(ctx, gw.GetId()); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("NAT gateway deleted", "id", gw.GetId())
+	return nil
+}
@@ -0,0 +1,224 @@
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+)
+
+const (
+	describePrefix     = "k8s cluster "
+	maxNameLength      = 63
+	resourceDeleted    = true
+	resourceNotDeleted = false
+)
+
+// Reconcile reconciles the managed network, its subnets and NAT gateway.
+// Does nothing if the network is not managed by the provider.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.scope.IsNetworkManaged() {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("reconciling managed network")
+
+	networkID, err := s.reconcileNetwork(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Publish the network ID as soon as it is known, so it is not lost if the subnets reconciliation fails.
+	status := infrav1.NetworkStatus{ID: networkID}
+	s.scope.SetNetworkStatus(status)
+
+	if s.scope.IsNATGatewayEnabled() {
+		status.NATGatewayID, err = s.reconcileNATGateway(ctx)
+		if err != nil {
+			return err
+		}
+		s.scope.SetNetworkStatus(status)
+
+		status.RouteTableID, err = s.reconcileRouteTable(ctx, networkID, status.NATGatewayID)
+		if err != nil {
+			return err
+		}
+		s.scope.SetNetworkStatus(status)
+	}
+
+	for _, spec := range s.scope.GetNetworkSpec().Subnets {
+		subnet, err := s.reconcileSubnet(ctx, networkID, status.RouteTableID, spec)
+		if err != nil {
+			return err
+		}
+
+		status.Subnets = append(status.Subnets, subnet)
+	}
+
+	s.scope.SetNetworkStatus(status)
+	return nil
+}
+
+// Delete deletes the managed network, its subnets and NAT gateway.
+// Returns true if the network does not exist anymore.
+func (s *Service) Delete(ctx context.Context) (bool, error) {
+	if !s.scope.IsNetworkManaged() {
+		return resourceDeleted, nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("deleting managed network")
+
+	client := s.scope.GetClient()
+	for _, spec := range s.scope.GetNetworkSpec().Subnets {
+		subnet, err := client.VPCSubnetGetByName(ctx, s.scope.GetFolderID(), s.getSubnetName(spec.ZoneID))
+		if err != nil {
+			return resourceNotDeleted, err
+		}
+
+		if subnet == nil {
+			continue
+		}
+
+		if err := client.VPCSubnetDelete(ctx, subnet.GetId()); err != nil {
+			return resourceNotDeleted, err
+		}
+		logger.Info("subnet deleted", "id", subnet.GetId())
+	}
+
+	// The route table could be deleted only after the subnets it is attached to,
+	// and the gateway only after the route table which refers to it.
+	if s.scope.IsNATGatewayEnabled() {
+		if err := s.deleteRouteTable(ctx); err != nil {
+			return resourceNotDeleted, err
+		}
+
+		if err := s.deleteNATGateway(ctx); err != nil {
+			return resourceNotDeleted, err
+		}
+	}
+
+	network, err := client.VPCNetworkGetByName(ctx, s.scope.GetFolderID(), s.scope.GetNetworkName())
+	if err != nil {
+		return resourceNotDeleted, err
+	}
+
+	if network != nil {
+		if err := client.VPCNetworkDelete(ctx, network.GetId()); err != nil {
+			return resourceNotDeleted, err
+		}
+		logger.Info("network deleted", "id", network.GetId())
+	}
+
+	s.scope.SetNetworkStatus(infrav1.NetworkStatus{})
+	return resourceDeleted, nil
+}
+
+// reconcileNetwork reconciles the managed network.
+// Returns ID of the network.
+func (s *Service) reconcileNetwork(ctx context.Context) (string, error) {
+	logger := log.FromContext(ctx)
+	client := s.scope.GetClient()
+
+	network, err := client.VPCNetworkGetByName(ctx, s.scope.GetFolderID(), s.scope.GetNetworkName())
+	if err != nil {
+		return "", err
+	}
+
+	if network != nil {
+		return network.GetId(), nil
+	}
+
+	logger.Info("creating network", "name", s.scope.GetNetworkName())
+	req := &vpc.CreateNetworkRequest{
+		FolderId:    s.scope.GetFolderID(),
+		Name:        s.scope.GetNetworkName(),
+		Description: describePrefix + s.scope.Name() + " network",
+	}
+	if labels := s.scope.GetLabels(); labels != nil {
+		req.SetLabels(labels)
+	}
+
+	id, err := client.VPCNetworkCreate(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info("network created", "id", id)
+	return id, nil
+}
+
+// reconcileSubnet reconciles the managed network subnet.
+// The route table is attached to the subnet, if routeTableID is not empty.
+// Returns the subnet status.
+func (s *Service) reconcileSubnet(ctx context.Context, networkID, routeTableID string, spec infrav1.NetworkSubnetSpec) (infrav1.SubnetStatus, error) {
+	logger := log.FromContext(ctx)
+	client := s.scope.GetClient()
+	name := s.getSubnetName(spec.ZoneID)
+
+	status := infrav1.SubnetStatus{
+		ZoneID:    spec.ZoneID,
+		CIDRBlock: spec.CIDRBlock,
+	}
+
+	subnet, err := client.VPCSubnetGetByName(ctx, s.scope.GetFolderID(), name)
+	if err != nil {
+		return status, err
+	}
+
+	if subnet != nil {
+		status.ID = subnet.GetId()
+		if routeTableID == "" || subnet.GetRouteTableId() == routeTableID {
+			return status, nil
+		}
+
+		logger.Info("attaching route table to subnet", "id", subnet.GetId(), "route table id", routeTableID)
+		req := &vpc.UpdateSubnetRequest{
+			SubnetId:     subnet.GetId(),
+			UpdateMask:   &fieldmaskpb.FieldMask{Paths: []string{"route_table_id"}},
+			RouteTableId: routeTableID,
+		}
+		return status, client.VPCSubnetUpdate(ctx, req)
+	}
+
+	logger.Info("creating subnet", "name", name, "zone", spec.ZoneID)
+	req := &vpc.CreateSubnetRequest{
+		FolderId:     s.scope.GetFolderID(),
+		Name:         name,
+		Description:  describePrefix + s.scope.Name() + " subnet",
+		NetworkId:    networkID,
+		ZoneId:       spec.ZoneID,
+		V4CidrBlocks: []string{spec.CIDRBlock},
+		RouteTableId: routeTableID,
+	}
+	if labels := s.scope.GetLabels(); labels != nil {
+		req.SetLabels(labels)
+	}
+
+	id, err := client.VPCSubnetCreate(ctx, req)
+	if err != nil {
+		return status, err
+	}
+
+	logger.Info("subnet created", "id", id)
+	status.ID = id
+	return status, nil
+}
+
+// getSubnetName returns the managed subnet name for the availability zone.
+// The network name is replaced with its hash, if resulting name length greater than maxNameLength characters.
+func (s *Service) getSubnetName(zoneID string) string {
+	name := fmt.Sprintf("%s-%s", s.scope.GetNetworkName(), zoneID)
+	if len(name) <= maxNameLength {
+		return name
+	}
+
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(s.scope.GetNetworkName())))
+	return fmt.Sprintf("network-%s-%s", hash[:16], zoneID)
+}
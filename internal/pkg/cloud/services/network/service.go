@@ -0,0 +1,20 @@
+package network
+
+import (
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+)
+
+// Service implements managed network reconciler.
+type Service struct {
+	scope *scope.ClusterScope
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns a new network service.
+func New(scp *scope.ClusterScope) *Service {
+	return &Service{
+		scope: scp,
+	}
+}
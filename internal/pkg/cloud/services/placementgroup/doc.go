@@ -0,0 +1,2 @@
+// Package placementgroup has all services and interface to work with the YandexCloud Compute placement group API.
+package placementgroup
@@ -0,0 +1,85 @@
+package placementgroup
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	describePrefix     = "k8s cluster "
+	resourceDeleted    = true
+	resourceNotDeleted = false
+)
+
+// Reconcile reconciles the managed control plane spread placement group.
+// Does nothing if the placement group is not managed by the provider.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.scope.IsControlPlanePlacementGroupManaged() {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("reconciling control plane placement group")
+	client := s.scope.GetClient()
+	name := s.scope.GetControlPlanePlacementGroupName()
+
+	pg, err := client.PlacementGroupGetByName(ctx, s.scope.GetFolderID(), name)
+	if err != nil {
+		return err
+	}
+
+	if pg != nil {
+		s.scope.SetControlPlanePlacementGroupID(pg.GetId())
+		return nil
+	}
+
+	logger.Info("creating placement group", "name", name)
+	req := &compute.CreatePlacementGroupRequest{
+		FolderId:    s.scope.GetFolderID(),
+		Name:        name,
+		Description: describePrefix + s.scope.Name() + " control plane placement group",
+		Labels:      s.scope.GetLabels(),
+		PlacementStrategy: &compute.CreatePlacementGroupRequest_SpreadPlacementStrategy{
+			SpreadPlacementStrategy: &compute.SpreadPlacementStrategy{},
+		},
+	}
+
+	id, err := client.PlacementGroupCreate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("placement group created", "id", id)
+	s.scope.SetControlPlanePlacementGroupID(id)
+	return nil
+}
+
+// Delete deletes the managed control plane placement group.
+// The placement group could be deleted only when all the control plane instances are deleted.
+// Returns true if the placement group does not exist anymore.
+func (s *Service) Delete(ctx context.Context) (bool, error) {
+	if !s.scope.IsControlPlanePlacementGroupManaged() {
+		return resourceDeleted, nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("deleting control plane placement group")
+	client := s.scope.GetClient()
+
+	pg, err := client.PlacementGroupGetByName(ctx, s.scope.GetFolderID(), s.scope.GetControlPlanePlacementGroupName())
+	if err != nil {
+		return resourceNotDeleted, err
+	}
+
+	if pg != nil {
+		if err := client.PlacementGroupDelete(ctx, pg.GetId()); err != nil {
+			return resourceNotDeleted, err
+		}
+		logger.Info("placement group deleted", "id", pg.GetId())
+	}
+
+	s.scope.SetControlPlanePlacementGroupID("")
+	return resourceDeleted, nil
+}
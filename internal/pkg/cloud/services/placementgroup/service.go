@@ -0,0 +1,20 @@
+package placementgroup
+
+import (
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/cloud/scope"
+)
+
+// Service implements managed control plane placement group reconciler.
+type Service struct {
+	scope *scope.ClusterScope
+}
+
+var _ cloud.Reconciler = &Service{}
+
+// New returns a new placement group service.
+func New(scp *scope.ClusterScope) *Service {
+	return &Service{
+		scope: scp,
+	}
+}
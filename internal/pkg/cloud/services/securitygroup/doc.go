@@ -0,0 +1,2 @@
+// Package securitygroup has all services and interface to work with the YandexCloud VPC security group API.
+package securitygroup
@@ -0,0 +1,233 @@
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	infrav1 "github.com/yandex-cloud/cluster-api-provider-yandex/api/v1alpha1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	describePrefix     = "k8s cluster "
+	resourceDeleted    = true
+	resourceNotDeleted = false
+
+	// selfPredefinedTarget is the YandexCloud predefined target for the members of the same security group.
+	selfPredefinedTarget = "self_security_group"
+	// healthcheckPredefinedTarget is the YandexCloud predefined target for the load balancer health checks.
+	healthcheckPredefinedTarget = "loadbalancer_healthchecks"
+	anyIPv4CIDR                 = "0.0.0.0/0"
+	anyIPv6CIDR                 = "::/0"
+	protocolAny                 = "ANY"
+	protocolTCP                 = "TCP"
+)
+
+// Reconcile reconciles the managed node security group and its rules.
+// The rules of the existing security group are replaced, if they differ from the expected ones.
+// Does nothing if the security group is not managed by the provider.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.scope.IsNodeSecurityGroupManaged() {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("reconciling node security group")
+	client := s.scope.GetClient()
+	name := s.scope.GetNodeSecurityGroupName()
+
+	sg, err := client.VPCSecurityGroupGetByName(ctx, s.scope.GetFolderID(), name)
+	if err != nil {
+		return err
+	}
+
+	lbSecurityGroupIDs, err := s.getLBSecurityGroupIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	ruleSpecs := s.createRuleSpecs(s.scope.IsNodeSecurityGroupIPv6(), lbSecurityGroupIDs)
+	if sg != nil {
+		s.scope.SetNodeSecurityGroupID(sg.GetId())
+		if isRulesEqual(sg.GetRules(), ruleSpecs) {
+			return nil
+		}
+
+		logger.Info("updating node security group rules", "id", sg.GetId())
+		return client.VPCSecurityGroupUpdate(ctx, &vpc.UpdateSecurityGroupRequest{
+			SecurityGroupId: sg.GetId(),
+			UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"rule_specs"}},
+			RuleSpecs:       ruleSpecs,
+		})
+	}
+
+	networkID := s.scope.GetNetworkID()
+	if networkID == "" {
+		return fmt.Errorf("network of the node security group is not known yet")
+	}
+
+	logger.Info("creating node security group", "name", name)
+	req := &vpc.CreateSecurityGroupRequest{
+		FolderId:    s.scope.GetFolderID(),
+		NetworkId:   networkID,
+		Name:        name,
+		Description: describePrefix + s.scope.Name() + " node security group",
+		Labels:      s.scope.GetLabels(),
+		RuleSpecs:   ruleSpecs,
+	}
+
+	id, err := client.VPCSecurityGroupCreate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("node security group created", "id", id)
+	s.scope.SetNodeSecurityGroupID(id)
+	return nil
+}
+
+// Delete deletes the managed node security group.
+// The security group could be deleted only when all the cluster machines are deleted.
+// Returns true if the security group does not exist anymore.
+func (s *Service) Delete(ctx context.Context) (bool, error) {
+	if !s.scope.IsNodeSecurityGroupManaged() {
+		return resourceDeleted, nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("deleting node security group")
+	client := s.scope.GetClient()
+
+	sg, err := client.VPCSecurityGroupGetByName(ctx, s.scope.GetFolderID(), s.scope.GetNodeSecurityGroupName())
+	if err != nil {
+		return resourceNotDeleted, err
+	}
+
+	if sg != nil {
+		if err := client.VPCSecurityGroupDelete(ctx, sg.GetId()); err != nil {
+			return resourceNotDeleted, err
+		}
+		logger.Info("node security group deleted", "id", sg.GetId())
+	}
+
+	s.scope.SetNodeSecurityGroupID("")
+	return resourceDeleted, nil
+}
+
+// createRuleSpecs returns the node security group rules.
+// The cluster machines accept any traffic from each other, so the kubelet, CNI and etcd traffic is allowed,
+// the kubernetes API traffic from the application load balancer security groups and the load balancer health checks.
+// The outgoing traffic is not restricted. The IPv6 addresses are allowed too, if the cluster uses IPv6.
+func (s *Service) createRuleSpecs(ipv6 bool, lbSecurityGroupIDs []string) []*vpc.SecurityGroupRuleSpec {
+	nodesRule := createRuleSpec(vpc.SecurityGroupRule_INGRESS, protocolAny, "cluster nodes traffic")
+	nodesRule.SetPredefinedTarget(selfPredefinedTarget)
+
+	healthcheckRule := createRuleSpec(vpc.SecurityGroupRule_INGRESS, protocolAny, "load balancer health checks")
+	healthcheckRule.SetPredefinedTarget(healthcheckPredefinedTarget)
+
+	egressRule := createRuleSpec(vpc.SecurityGroupRule_EGRESS, protocolAny, "outgoing traffic")
+	egressRule.SetCidrBlocks(anyCIDRBlocks(ipv6))
+
+	rules := []*vpc.SecurityGroupRuleSpec{nodesRule, healthcheckRule, egressRule}
+
+	apiPort := int64(s.scope.GetLBSpec().BackendPort)
+	for _, id := range lbSecurityGroupIDs {
+		apiRule := createRuleSpec(vpc.SecurityGroupRule_INGRESS, protocolTCP, "kubernetes api")
+		apiRule.SetPorts(&vpc.PortRange{FromPort: apiPort, ToPort: apiPort})
+		apiRule.SetSecurityGroupId(id)
+		rules = append(rules, apiRule)
+	}
+
+	return rules
+}
+
+// getLBSecurityGroupIDs returns the security groups of the application load balancer,
+// which proxies the kubernetes API traffic to the cluster machines.
+// The network load balancer keeps the client addresses, so the kubernetes API traffic through it
+// is allowed by the security groups attached to the control plane machines only.
+// Returns nothing if the managed security group of the load balancer is not created yet.
+func (s *Service) getLBSecurityGroupIDs(ctx context.Context) ([]string, error) {
+	lbs := s.scope.GetLBSpec()
+	if lbs.Type != infrav1.LoadBalancerTypeALB {
+		return nil, nil
+	}
+	if len(lbs.SecurityGroups) > 0 {
+		return lbs.SecurityGroups, nil
+	}
+
+	sg, err := s.scope.GetClient().VPCSecurityGroupGetByName(ctx, s.scope.GetFolderID(), s.scope.GetLBName())
+	if err != nil {
+		return nil, err
+	}
+	if sg == nil {
+		return nil, nil
+	}
+
+	return []string{sg.GetId()}, nil
+}
+
+// anyCIDRBlocks returns the CIDR blocks matching any IPv4 address and any IPv6 address, if ipv6 is true.
+func anyCIDRBlocks(ipv6 bool) *vpc.CidrBlocks {
+	blocks := &vpc.CidrBlocks{V4CidrBlocks: []string{anyIPv4CIDR}}
+	if ipv6 {
+		blocks.V6CidrBlocks = []string{anyIPv6CIDR}
+	}
+	return blocks
+}
+
+// securityGroupRule is the common part of the existing security group rule and the rule specification.
+type securityGroupRule interface {
+	GetDescription() string
+	GetDirection() vpc.SecurityGroupRule_Direction
+	GetProtocolName() string
+	GetPorts() *vpc.PortRange
+	GetCidrBlocks() *vpc.CidrBlocks
+	GetPredefinedTarget() string
+	GetSecurityGroupId() string
+}
+
+// isRulesEqual returns true if the existing security group rules match the rule specifications.
+func isRulesEqual(rules []*vpc.SecurityGroupRule, specs []*vpc.SecurityGroupRuleSpec) bool {
+	if len(rules) != len(specs) {
+		return false
+	}
+
+	keys := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		keys = append(keys, getRuleKey(rule))
+	}
+	specKeys := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		specKeys = append(specKeys, getRuleKey(spec))
+	}
+	slices.Sort(keys)
+	slices.Sort(specKeys)
+
+	return slices.Equal(keys, specKeys)
+}
+
+// getRuleKey returns the key identifying the traffic allowed by the security group rule.
+func getRuleKey(rule securityGroupRule) string {
+	v4CidrBlocks := slices.Clone(rule.GetCidrBlocks().GetV4CidrBlocks())
+	slices.Sort(v4CidrBlocks)
+	v6CidrBlocks := slices.Clone(rule.GetCidrBlocks().GetV6CidrBlocks())
+	slices.Sort(v6CidrBlocks)
+	return fmt.Sprintf("%s|%s|%s|%d-%d|%s|%s|%s|%s",
+		rule.GetDescription(), rule.GetDirection(), rule.GetProtocolName(),
+		rule.GetPorts().GetFromPort(), rule.GetPorts().GetToPort(),
+		strings.Join(v4CidrBlocks, ","), strings.Join(v6CidrBlocks, ","),
+		rule.GetPredefinedTarget(), rule.GetSecurityGroupId())
+}
+
+// createRuleSpec returns the security group rule for any port.
+func createRuleSpec(direction vpc.SecurityGroupRule_Direction, protocol, description string) *vpc.SecurityGroupRuleSpec {
+	rule := &vpc.SecurityGroupRuleSpec{}
+	rule.SetDescription(description)
+	rule.SetDirection(direction)
+	rule.SetProtocolName(protocol)
+	return rule
+}
@@ -23,20 +23,32 @@ type MetricContext struct {
 
 // Metrics labels
 const (
-	metricYCSubsystem           string = "yc" // yandexcloud or capy
-	metricRequestCountKey       string = "api_requests"
-	metricRequestDurationKey    string = "api_request_duration_seconds"
-	metricServiceLabel          string = "object"     // compute, loadbalancer
-	metricControllerLabel       string = "controller" // yandexmachine, yandexcluster
-	metricStatusLabel           string = "status"
-	StatusFailed                string = "failed"
-	StatusSuccess               string = "success"
-	ServiceLabelCompute         string = "compute"
-	ServiceLabelAlbTargetGroup  string = "alb-target-group"
-	ServiceLabelAlbBackendGroup string = "alb-backend-group"
-	ServiceLabelAlb             string = "alb"
-	ServiceLabelNlbTargetGroup  string = "nlb-target-group"
-	ControllerLabelMachine      string = "yandexmachine"
+	metricYCSubsystem            string = "yc" // yandexcloud or capy
+	metricRequestCountKey        string = "api_requests"
+	metricRequestDurationKey     string = "api_request_duration_seconds"
+	metricServiceLabel           string = "object"     // compute, loadbalancer
+	metricControllerLabel        string = "controller" // yandexmachine, yandexcluster
+	metricStatusLabel            string = "status"
+	StatusFailed                 string = "failed"
+	StatusSuccess                string = "success"
+	ServiceLabelCompute          string = "compute"
+	ServiceLabelComputeImage     string = "compute-image"
+	ServiceLabelComputeDisk      string = "compute-disk"
+	ServiceLabelPlacementGroup   string = "placement-group"
+	ServiceLabelInstanceGroup    string = "instance-group"
+	ServiceLabelAlbTargetGroup   string = "alb-target-group"
+	ServiceLabelAlbBackendGroup  string = "alb-backend-group"
+	ServiceLabelAlb              string = "alb"
+	ServiceLabelNlbTargetGroup   string = "nlb-target-group"
+	ServiceLabelNlb              string = "nlb"
+	ServiceLabelVPCSecurityGroup string = "vpc-security-group"
+	ServiceLabelVPCNetwork       string = "vpc-network"
+	ServiceLabelVPCSubnet        string = "vpc-subnet"
+	ServiceLabelVPCGateway       string = "vpc-gateway"
+	ServiceLabelVPCRouteTable    string = "vpc-route-table"
+	ServiceLabelVPCAddress       string = "vpc-address"
+	ServiceLabelObjectStorage    string = "object-storage"
+	ControllerLabelMachine       string = "yandexmachine"
 )
 
 var durationBuckets = []float64{0, .1, .25, .5, .75, 1., 5.}
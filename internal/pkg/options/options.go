@@ -1,9 +1,84 @@
 package options
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// AuthMode is the method the controllers authenticate in YandexCloud API with.
+type AuthMode string
+
+const (
+	// AuthModeSAKey authenticates with the service account authorized key from YC_SA_KEY.
+	AuthModeSAKey AuthMode = "sa-key"
+	// AuthModeInstanceSA authenticates as the service account linked to the VM the controllers run on.
+	AuthModeInstanceSA AuthMode = "instance-sa"
+	// AuthModeIAMTokenFile authenticates with the IAM token read from YC_TOKEN_FILE.
+	// The file is re-read on token expiration, so the token can be rotated.
+	AuthModeIAMTokenFile AuthMode = "iam-token-file"
+	// AuthModeOAuthTokenFile authenticates with the OAuth token read from YC_TOKEN_FILE.
+	// The file is re-read on token expiration, so the token can be rotated.
+	AuthModeOAuthTokenFile AuthMode = "oauth-token-file"
+	// AuthModeWorkloadIdentity exchanges the Kubernetes service account token read from YC_TOKEN_FILE
+	// for the IAM token of the service account YC_WORKLOAD_IDENTITY_SA_ID using workload identity federation.
+	AuthModeWorkloadIdentity AuthMode = "workload-identity"
+)
 
 // Config describes all environment variables required for controllers
 type Config struct {
-	YandexCloudSAKey string        `env:"YC_SA_KEY,notEmpty"`
-	ReconcileTimeout time.Duration `env:"CAPY_RECONCILE_TIMEOUT" envDefault:"1m"`
+	AuthMode                         AuthMode      `env:"YC_AUTH_MODE" envDefault:"sa-key"`
+	YandexCloudSAKey                 string        `env:"YC_SA_KEY"`
+	TokenFile                        string        `env:"YC_TOKEN_FILE"`
+	WorkloadIdentityServiceAccountID string        `env:"YC_WORKLOAD_IDENTITY_SA_ID"`
+	WorkloadIdentityTokenURL         string        `env:"YC_WORKLOAD_IDENTITY_TOKEN_URL" envDefault:"https://auth.yandex.cloud/oauth/token"`
+	ReconcileTimeout                 time.Duration `env:"CAPY_RECONCILE_TIMEOUT" envDefault:"1m"`
+
+	// DriftCheckInterval is the interval the running machines are compared with their specification at.
+	// Zero disables the periodic check, so the machines are compared on the YandexMachine changes only.
+	DriftCheckInterval time.Duration `env:"CAPY_DRIFT_CHECK_INTERVAL" envDefault:"5m"`
+
+	// BootstrapS3Bucket enables the delivery of the machine bootstrap data through the object storage bucket.
+	BootstrapS3Bucket          string        `env:"CAPY_BOOTSTRAP_S3_BUCKET"`
+	BootstrapS3Endpoint        string        `env:"CAPY_BOOTSTRAP_S3_ENDPOINT" envDefault:"https://storage.yandexcloud.net"`
+	BootstrapS3Region          string        `env:"CAPY_BOOTSTRAP_S3_REGION" envDefault:"ru-central1"`
+	BootstrapS3AccessKeyID     string        `env:"CAPY_BOOTSTRAP_S3_ACCESS_KEY_ID"`
+	BootstrapS3SecretAccessKey string        `env:"CAPY_BOOTSTRAP_S3_SECRET_ACCESS_KEY"`
+	BootstrapS3URLExpiration   time.Duration `env:"CAPY_BOOTSTRAP_S3_URL_EXPIRATION" envDefault:"1h"`
+}
+
+// IsBootstrapStorageEnabled returns true if the machine bootstrap data is delivered through the object storage.
+func (c Config) IsBootstrapStorageEnabled() bool {
+	return c.BootstrapS3Bucket != ""
+}
+
+// Validate checks that all environment variables required by the authentication mode are set.
+func (c Config) Validate() error {
+	switch c.AuthMode {
+	case AuthModeSAKey:
+		if c.YandexCloudSAKey == "" {
+			return fmt.Errorf("YC_SA_KEY is required for the %s authentication mode", c.AuthMode)
+		}
+	case AuthModeInstanceSA:
+	case AuthModeIAMTokenFile, AuthModeOAuthTokenFile:
+		if c.TokenFile == "" {
+			return fmt.Errorf("YC_TOKEN_FILE is required for the %s authentication mode", c.AuthMode)
+		}
+	case AuthModeWorkloadIdentity:
+		if c.TokenFile == "" || c.WorkloadIdentityServiceAccountID == "" {
+			return fmt.Errorf("YC_TOKEN_FILE and YC_WORKLOAD_IDENTITY_SA_ID are required for the %s authentication mode", c.AuthMode)
+		}
+	default:
+		return fmt.Errorf("unknown authentication mode %q", c.AuthMode)
+	}
+
+	if c.DriftCheckInterval < 0 {
+		return fmt.Errorf("CAPY_DRIFT_CHECK_INTERVAL must not be negative")
+	}
+
+	if c.IsBootstrapStorageEnabled() && (c.BootstrapS3AccessKeyID == "" || c.BootstrapS3SecretAccessKey == "") {
+		return fmt.Errorf("CAPY_BOOTSTRAP_S3_ACCESS_KEY_ID and CAPY_BOOTSTRAP_S3_SECRET_ACCESS_KEY are required " +
+			"for the bootstrap data delivery through the object storage")
+	}
+
+	return nil
 }
@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name    string
+		config  options.Config
+		wantErr string
+	}{
+		{
+			name:   "service account key",
+			config: options.Config{AuthMode: options.AuthModeSAKey, YandexCloudSAKey: "{}"},
+		},
+		{
+			name:    "service account key without YC_SA_KEY",
+			config:  options.Config{AuthMode: options.AuthModeSAKey},
+			wantErr: "YC_SA_KEY is required",
+		},
+		{
+			name:   "instance service account",
+			config: options.Config{AuthMode: options.AuthModeInstanceSA},
+		},
+		{
+			name:   "IAM token file",
+			config: options.Config{AuthMode: options.AuthModeIAMTokenFile, TokenFile: "/var/run/token"},
+		},
+		{
+			name:    "IAM token file without YC_TOKEN_FILE",
+			config:  options.Config{AuthMode: options.AuthModeIAMTokenFile},
+			wantErr: "YC_TOKEN_FILE is required",
+		},
+		{
+			name:   "OAuth token file",
+			config: options.Config{AuthMode: options.AuthModeOAuthTokenFile, TokenFile: "/var/run/token"},
+		},
+		{
+			name:    "OAuth token file without YC_TOKEN_FILE",
+			config:  options.Config{AuthMode: options.AuthModeOAuthTokenFile},
+			wantErr: "YC_TOKEN_FILE is required",
+		},
+		{
+			name: "workload identity",
+			config: options.Config{
+				AuthMode:                         options.AuthModeWorkloadIdentity,
+				TokenFile:                        "/var/run/token",
+				WorkloadIdentityServiceAccountID: "aje-service-account",
+			},
+		},
+		{
+			name:    "workload identity without YC_WORKLOAD_IDENTITY_SA_ID",
+			config:  options.Config{AuthMode: options.AuthModeWorkloadIdentity, TokenFile: "/var/run/token"},
+			wantErr: "YC_WORKLOAD_IDENTITY_SA_ID are required",
+		},
+		{
+			name: "workload identity without YC_TOKEN_FILE",
+			config: options.Config{
+				AuthMode:                         options.AuthModeWorkloadIdentity,
+				WorkloadIdentityServiceAccountID: "aje-service-account",
+			},
+			wantErr: "YC_TOKEN_FILE and YC_WORKLOAD_IDENTITY_SA_ID are required",
+		},
+		{
+			name:    "unknown authentication mode",
+			config:  options.Config{AuthMode: "password"},
+			wantErr: "unknown authentication mode",
+		},
+		{
+			name: "bootstrap storage without access keys",
+			config: options.Config{
+				AuthMode:          options.AuthModeInstanceSA,
+				BootstrapS3Bucket: "bootstrap",
+			},
+			wantErr: "CAPY_BOOTSTRAP_S3_ACCESS_KEY_ID and CAPY_BOOTSTRAP_S3_SECRET_ACCESS_KEY are required",
+		},
+		{
+			name: "negative drift check interval",
+			config: options.Config{
+				AuthMode:           options.AuthModeInstanceSA,
+				DriftCheckInterval: -time.Minute,
+			},
+			wantErr: "CAPY_DRIFT_CHECK_INTERVAL must not be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			err := test.config.Validate()
+			if test.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.wantErr)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
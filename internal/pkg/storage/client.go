@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/metrics"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
+)
+
+// maxPresignExpiration is the longest presigned URL lifetime allowed by signature version 4.
+const maxPresignExpiration = 7 * 24 * time.Hour
+
+// Client is S3-compatible object storage client.
+type Client interface {
+	// PutObject uploads the object to the bucket.
+	PutObject(ctx context.Context, key string, data []byte) error
+	// DeleteObject deletes the object from the bucket, it is not an error if the object does not exist.
+	DeleteObject(ctx context.Context, key string) error
+	// PresignGetObject returns the URL the object could be downloaded by without credentials until it expires.
+	PresignGetObject(key string) (string, error)
+}
+
+// S3Client implements Client for the S3-compatible object storage with path-style bucket addressing.
+type S3Client struct {
+	endpoint   *url.URL
+	bucket     string
+	expiration time.Duration
+	signer     *signer
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+var _ Client = &S3Client{}
+
+// NewClientFromConfig returns the bootstrap data object storage client from the controller configuration.
+func NewClientFromConfig(cfg options.Config) (*S3Client, error) {
+	endpoint, err := url.Parse(cfg.BootstrapS3Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object storage endpoint %q: %w", cfg.BootstrapS3Endpoint, err)
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid object storage endpoint %q: scheme and host are required", cfg.BootstrapS3Endpoint)
+	}
+	if cfg.BootstrapS3URLExpiration <= 0 || cfg.BootstrapS3URLExpiration > maxPresignExpiration {
+		return nil, fmt.Errorf("presigned URL expiration %s must be positive and not longer than %s",
+			cfg.BootstrapS3URLExpiration, maxPresignExpiration)
+	}
+
+	return &S3Client{
+		endpoint:   endpoint,
+		bucket:     cfg.BootstrapS3Bucket,
+		expiration: cfg.BootstrapS3URLExpiration,
+		signer: &signer{
+			accessKeyID:     cfg.BootstrapS3AccessKeyID,
+			secretAccessKey: cfg.BootstrapS3SecretAccessKey,
+			region:          cfg.BootstrapS3Region,
+		},
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}, nil
+}
+
+// PutObject uploads the object to the bucket.
+func (c *S3Client) PutObject(ctx context.Context, key string, data []byte) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelObjectStorage)
+	err := c.do(ctx, http.MethodPut, key, data, http.StatusOK)
+	mc.ObserveRequest(err)
+	return err
+}
+
+// DeleteObject deletes the object from the bucket, it is not an error if the object does not exist.
+func (c *S3Client) DeleteObject(ctx context.Context, key string) error {
+	mc := metrics.NewMetricContext(metrics.ControllerLabelMachine, metrics.ServiceLabelObjectStorage)
+	err := c.do(ctx, http.MethodDelete, key, nil, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+	mc.ObserveRequest(err)
+	return err
+}
+
+// PresignGetObject returns the URL the object could be downloaded by without credentials until it expires.
+func (c *S3Client) PresignGetObject(key string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.objectURL(key), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+
+	c.signer.presign(req, c.expiration, c.now())
+	return req.URL.String(), nil
+}
+
+// do sends the signed object request and checks the response status.
+func (c *S3Client) do(ctx context.Context, method, key string, body []byte, expectedStatuses ...int) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(body))
+
+	c.signer.sign(req, hashSHA256(body), c.now())
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to %s object %s/%s: %w", strings.ToLower(method), c.bucket, key, err)
+	}
+	defer resp.Body.Close()
+
+	for _, status := range expectedStatuses {
+		if resp.StatusCode == status {
+			return nil
+		}
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("failed to %s object %s/%s: unexpected status %s: %s",
+		strings.ToLower(method), c.bucket, key, resp.Status, strings.TrimSpace(string(message)))
+}
+
+// objectURL returns the path-style URL of the object.
+func (c *S3Client) objectURL(key string) string {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket + "/" + key
+	u.RawPath = ""
+	return u.String()
+}
@@ -0,0 +1,2 @@
+// Package storage implements S3-compatible object storage client used to deliver the machine bootstrap data.
+package storage
@@ -33,6 +33,8 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -41,6 +43,7 @@ import (
 	yandex "github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/client"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/metrics"
 	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/options"
+	"github.com/yandex-cloud/cluster-api-provider-yandex/internal/pkg/storage"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -54,6 +57,8 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(clusterv1.AddToScheme(scheme))
+	utilruntime.Must(expclusterv1.AddToScheme(scheme))
+	utilruntime.Must(ipamv1.AddToScheme(scheme))
 	utilruntime.Must(infrav1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 	metrics.RegisterAPIMetrics()
@@ -82,6 +87,10 @@ func main() {
 		setupLog.Error(err, "unable to parse envs")
 		os.Exit(1)
 	}
+	if err := cfg.Validate(); err != nil {
+		setupLog.Error(err, "invalid envs")
+		os.Exit(1)
+	}
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
@@ -108,7 +117,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	yandexClient, err := yandex.GetClient(ctx, cfg.YandexCloudSAKey)
+	yandexClient, err := yandex.GetClientFromConfig(ctx, cfg)
 	if err != nil {
 		setupLog.Error(err, "unable to init Yandex SDK client")
 		os.Exit(1)
@@ -118,21 +127,49 @@ func main() {
 		yandexClient.Close(ctx)
 	}()
 
+	// clientCache keeps the clients built for the YandexClusterIdentity credentials.
+	clientCache := yandex.NewClientCache(yandex.GetClient)
+	defer clientCache.Close(ctx)
+
 	if err = (&controllers.YandexClusterReconciler{
 		Client:       mgr.GetClient(),
 		Scheme:       mgr.GetScheme(),
 		YandexClient: yandexClient,
+		ClientCache:  clientCache,
 		Config:       cfg,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "YandexCluster")
 		os.Exit(1)
 	}
+	// bootstrapStorage is the object storage client for the bootstrap data delivery, if it is enabled.
+	var bootstrapStorage storage.Client
+	if cfg.IsBootstrapStorageEnabled() {
+		s3Client, err := storage.NewClientFromConfig(cfg)
+		if err != nil {
+			setupLog.Error(err, "unable to init object storage client")
+			os.Exit(1)
+		}
+		bootstrapStorage = s3Client
+	}
+
 	if err = (&controllers.YandexMachineReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		YandexClient:     yandexClient,
+		ClientCache:      clientCache,
+		BootstrapStorage: bootstrapStorage,
+		Config:           cfg,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "YandexMachine")
+		os.Exit(1)
+	}
+	if err = (&controllers.YandexMachinePoolReconciler{
 		Client:       mgr.GetClient(),
 		Scheme:       mgr.GetScheme(),
 		YandexClient: yandexClient,
+		ClientCache:  clientCache,
 	}).SetupWithManager(ctx, mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "YandexMachine")
+		setupLog.Error(err, "unable to create controller", "controller", "YandexMachinePool")
 		os.Exit(1)
 	}
 
@@ -149,6 +186,10 @@ func main() {
 		setupLog.Error(err, "unable to create webhook", "webhook", "YandexCluster")
 		os.Exit(1)
 	}
+	if err = (&infrav1.YandexMachinePool{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "YandexMachinePool")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {